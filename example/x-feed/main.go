@@ -23,13 +23,13 @@ THE SOFTWARE.
 package main
 
 import (
-	"errors"
+	"context"
+	"os"
+	"os/signal"
 
-	"github.com/J-Siu/go-dtquery/dq"
 	"github.com/J-Siu/go-helper/v2/ezlog"
 	"github.com/J-Siu/go-is/v3/example/x-feed/xfp"
 	"github.com/J-Siu/go-is/v3/is"
-	"github.com/runZeroInc/go-rod"
 )
 
 // (2) Write `main`
@@ -41,23 +41,28 @@ func main() {
 
 	var (
 		err      error
-		page     *rod.Page
+		browser  *is.Browser
+		page     *is.RodPage
 		property is.Property
 		x        *xfp.XFeedProcessor
 	)
 
-	page, err = getTab("localhost", 9222)
-	ezlog.Trace().N("page").M(page).Out()
+	// Stop on Ctrl-C
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// Attach to a running browser with devtools on port 9222, use the first tab.
+	// Use `&is.BrowserProperty{UserDataDir: dir}` to launch a local headless browser instead.
+	browser, err = is.NewBrowser(ctx, &is.BrowserProperty{ControlURL: "localhost:9222"})
 	if err == nil {
-		if page == nil {
-			err = errors.New("page is nil")
-		}
+		defer browser.Close()
+		page, err = browser.Page("", "")
 	}
 	if err == nil {
 		// (2.1) Prepare a `is.Property` object, populate field as needed
 		property = is.Property{
 			IInfoList: new(is.IInfoList), // Initialize this to use build-in info array
-			Page:      page,              // (2.1) REQUIRED: populate `Page` field (a `*rod.Page`, representing a browser tab)
+			Page:      page,              // (2.1) REQUIRED: populate `Page` field (a browser tab, eg. from `is.Browser`)
 			ScrollMax: 10,                // number of time we will scroll, -1 for infinite (default: 0)
 			UrlLoad:   true,
 			UrlStr:    "https://x.com/home",
@@ -71,8 +76,9 @@ func main() {
 		err = x.Err
 	}
 	if err == nil {
-		// (2.4) Call `Run`
-		x.Run()
+		// (2.4) Call `Run`, or `RunContext` to stop on Ctrl-C
+		report := x.RunContext(ctx)
+		ezlog.Debug().N("report").Lm(report).Out()
 		err = x.Err
 	}
 	if err == nil {
@@ -82,40 +88,3 @@ func main() {
 		ezlog.Err().M(err).Out()
 	}
 }
-
-// Helper function to connect to remote/running devtools with host and port only
-//
-// [dq] is not part of, [IS] package
-func getTab(host string, port int) (page *rod.Page, err error) {
-	prefix := "GetTab"
-	ezlog.Trace().N(prefix).TxtStart().Out()
-	var (
-		browser *rod.Browser
-		pages   rod.Pages
-	)
-
-	// use [dq] to get devtools info
-	devtools := dq.Get(host, port)
-	err = devtools.Err
-
-	// setup [rod]
-	if err == nil {
-		browser = rod.New().ControlURL(devtools.DT_Url)
-		err = browser.Connect()
-	}
-	if err == nil {
-		pages, err = browser.NoDefaultDevice().Pages()
-	}
-	if err == nil {
-		ezlog.Trace().N(prefix).N("pages").M(pages).Out()
-		page = pages.First()
-		if page != nil {
-			page.Activate()
-		}
-	}
-	if err != nil {
-		err = errors.New(prefix + ": Err: " + err.Error())
-	}
-	ezlog.Trace().N(prefix).TxtEnd().Out()
-	return page, err
-}
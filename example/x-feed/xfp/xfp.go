@@ -27,7 +27,6 @@ package xfp
 import (
 	"github.com/J-Siu/go-helper/v2/ezlog"
 	"github.com/J-Siu/go-is/v3/is"
-	"github.com/runZeroInc/go-rod"
 )
 
 // (1.1) Write a `info` struct
@@ -42,6 +41,9 @@ type XFeedInfo struct {
 // (1.1) REQUIRED: embed `is.InfoBase` for `is.IInfo` interface
 func (xi *XFeedInfo) String() string { return xi.User + ": " + xi.Text }
 
+// Optional: register info type, so `is.IInfoList` of `XFeedInfo` can be unmarshalled
+func init() { is.RegisterInfo("xf", new(XFeedInfo)) }
+
 // (1.2) Write a `processor` struct
 type XFeedProcessor struct {
 	*is.Processor // (1.2) REQUIRED: embed `*is.Processor`
@@ -66,12 +68,18 @@ func (t *XFeedProcessor) override() {
 func (t *XFeedProcessor) override_V20() {
 	prefix := t.MyType + ".V020"
 	t.StateCurr.Name = prefix
-	var es rod.Elements
-	tagName := "article"
+	var (
+		err     error
+		es      []is.IElement
+		tagName = "article"
+	)
 	if t.StateCurr.Element == nil {
-		es = t.Page.MustElements(tagName)
+		es, err = t.Page.Elements(tagName)
 	} else {
-		es = t.StateCurr.Element.MustElements(tagName)
+		es, err = t.StateCurr.Element.Elements(tagName)
+	}
+	if err != nil {
+		t.Err = err
 	}
 	t.StateCurr.Elements = es
 }
@@ -79,11 +87,13 @@ func (t *XFeedProcessor) override_V20() {
 func (t *XFeedProcessor) override_V30() {
 	prefix := t.MyType + ".V030"
 	t.StateCurr.Name = prefix
-	ezlog.Trace().M(t.StateCurr.Element.MustHTML()).Out()
+	if html, err := t.StateCurr.Element.HTML(); err == nil {
+		ezlog.Trace().M(html).Out()
+	}
 	info := new(XFeedInfo)
 	var (
 		err error
-		e   *rod.Element
+		e   is.IElement
 		tag string
 	)
 
@@ -94,7 +104,7 @@ func (t *XFeedProcessor) override_V30() {
 		tag = "a"
 		e, err = e.Element(tag)
 		if err == nil && e != nil {
-			info.User = e.MustText()
+			info.User, _ = e.Text()
 		}
 	}
 
@@ -102,7 +112,7 @@ func (t *XFeedProcessor) override_V30() {
 	tag = "[data-testid='tweetText']"
 	e, err = t.StateCurr.Element.Element(tag)
 	if err == nil && e != nil {
-		info.Text = e.MustText()
+		info.Text, _ = e.Text()
 	}
 	ezlog.Debug().N(prefix).N("info").Lm(info).Out()
 	t.StateCurr.ElementInfo = info
@@ -3,9 +3,9 @@ module github.com/J-Siu/go-is/v3
 go 1.26.3
 
 require (
-	github.com/J-Siu/go-dtquery v1.2.4
 	github.com/J-Siu/go-helper/v2 v2.8.4
 	github.com/runZeroInc/go-rod v0.0.29 // replace github.com/go-rod/rod
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
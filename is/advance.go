@@ -0,0 +1,129 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package is
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// How [Processor.Run] advances to more elements
+type AdvanceMode int8
+
+const (
+	AdvanceScroll   AdvanceMode = iota // Use [Processor.ScrollElement] (default)
+	AdvanceClick                       // Click [Property.AdvanceSelector]. eg. a "Show more" button
+	AdvanceNextLink                    // Navigate to href of [Property.AdvanceSelector]. eg. a "Next" pagination link
+)
+
+// Default [Property.AdvanceTimeout]
+const AdvanceTimeoutDefault = 10 * time.Second
+
+func (t *Processor) base_Advance() {
+	prefix := t.MyType + ".Advance" + "(base)"
+	t.StateCurr.Name = prefix
+	var (
+		err   error
+		found bool
+	)
+	if t.AdvanceSelector == "" {
+		err = errors.New("AdvanceSelector is empty")
+	}
+	if err == nil {
+		found, t.StateCurr.AdvanceElement, err = t.Page.Has(t.AdvanceSelector)
+	}
+	if err == nil && !found {
+		// no more button/link, end of feed
+		t.StateCurr.AdvanceEnd = true
+		if t.Logger != nil {
+			t.Logger.Debug().N(prefix).N("not found").M(t.AdvanceSelector).Out()
+		}
+		return
+	}
+	if err == nil {
+		switch t.AdvanceMode {
+		case AdvanceClick:
+			err = t.advanceClick()
+		case AdvanceNextLink:
+			err = t.advanceNextLink()
+		}
+	}
+	if err == nil && !t.StateCurr.AdvanceEnd {
+		err = t.wait(t.WaitScroll)
+	}
+	if err != nil {
+		t.Err = fmt.Errorf("%s: %w", prefix, err)
+	}
+}
+
+// Click [StateCurr.AdvanceElement] once it is enabled
+//
+// Wait up to [Property.AdvanceTimeout]. A button still disabled or not interactable by then is treated as end of feed.
+func (t *Processor) advanceClick() (err error) {
+	timeout := t.AdvanceTimeout
+	if timeout <= 0 {
+		timeout = AdvanceTimeoutDefault
+	}
+	ctx, cancel := context.WithTimeout(t.Page.GetContext(), timeout)
+	defer cancel()
+	element := t.StateCurr.AdvanceElement.Context(ctx)
+	err = element.WaitEnabled()
+	if err == nil {
+		err = element.Click()
+	}
+	if errors.Is(err, context.DeadlineExceeded) && t.Page.GetContext().Err() == nil {
+		t.StateCurr.AdvanceEnd = true
+		if t.Logger != nil {
+			t.Logger.Debug().N(t.MyType + ".Advance").N("not clickable").M(t.AdvanceSelector).Out()
+		}
+		err = nil
+	}
+	return err
+}
+
+// Navigate to href of [StateCurr.AdvanceElement]
+//
+// [StateCurr.ElementsCount] is reset to 0 as elements of the new page start from index 0.
+func (t *Processor) advanceNextLink() (err error) {
+	var href string
+	res, err := t.StateCurr.AdvanceElement.Property("href")
+	if err == nil {
+		href = res.Str()
+		if href == "" {
+			err = errors.New("href is empty: " + t.AdvanceSelector)
+		}
+	}
+	if err == nil {
+		if t.Logger != nil {
+			t.Logger.Debug().N(t.MyType + ".Advance").N("next").M(href).Out()
+		}
+		err = t.Page.Navigate(href)
+	}
+	if err == nil {
+		t.StateCurr.Navigated = true
+		t.StateCurr.ElementsCount = 0
+	}
+	return err
+}
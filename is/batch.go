@@ -0,0 +1,104 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package is
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Result of [Property.ExtractJS] for one element
+type BatchInfo struct {
+	Index int   // Index in [State.Elements]
+	Info  IInfo // `nil` if extractor returned null or element is detached
+	Err   error // Error thrown by extractor, or unmarshal error
+}
+
+// Run [ExtractJS] on all new elements with one [IPage.ExtractJS] call, put results into [StateCurr.BatchInfos]
+func (t *Processor) batchInfo() {
+	prefix := t.MyType + ".V030Batch"
+	t.StateCurr.Name = prefix
+	var (
+		err   error
+		items []json.RawMessage
+	)
+	if t.ExtractNew == nil {
+		err = errors.New("ExtractNew is nil")
+	}
+	if err == nil {
+		elements := make([]IElement, len(t.StateCurr.ElementsNewIndex))
+		for i, index := range t.StateCurr.ElementsNewIndex {
+			elements[i] = t.StateCurr.Elements[index]
+		}
+		items, err = t.Page.ExtractJS(t.ExtractJS, elements)
+	}
+	if err == nil && len(items) != len(t.StateCurr.ElementsNewIndex) {
+		err = errors.New("result count " + strconv.Itoa(len(items)) + " != element count " + strconv.Itoa(len(t.StateCurr.ElementsNewIndex)))
+	}
+	if err != nil {
+		t.Err = fmt.Errorf("%s: %w", prefix, err)
+		return
+	}
+	t.StateCurr.BatchInfos = make([]BatchInfo, len(items))
+	t.batchIndex = make(map[int]int, len(items))
+	for i, item := range items {
+		bi := &t.StateCurr.BatchInfos[i]
+		bi.Index = t.StateCurr.ElementsNewIndex[i]
+		t.batchIndex[bi.Index] = i
+		if string(item) == "null" {
+			continue
+		}
+		var jsErr struct {
+			Err *string `json:"__error"`
+		}
+		if json.Unmarshal(item, &jsErr) == nil && jsErr.Err != nil {
+			bi.Err = errors.New("ExtractJS: " + *jsErr.Err)
+			continue
+		}
+		bi.Info = t.ExtractNew()
+		if bi.Err = json.Unmarshal(item, bi.Info); bi.Err != nil {
+			bi.Info = nil
+		}
+	}
+	if t.Logger != nil {
+		t.Logger.Trace().N(prefix).N("count").M(len(items)).Out()
+	}
+}
+
+// V030 replacement in batch mode: take [StateCurr.ElementInfo] from [StateCurr.BatchInfos]
+func (t *Processor) batchElementInfo() {
+	prefix := t.MyType + ".V030_ElementInfo" + "(batch)"
+	t.StateCurr.Name = prefix
+	i, ok := t.batchIndex[t.StateCurr.ElementIndex]
+	if !ok || i >= len(t.StateCurr.BatchInfos) {
+		return
+	}
+	bi := t.StateCurr.BatchInfos[i]
+	if bi.Err != nil {
+		t.Err = fmt.Errorf("%s: %w", prefix, bi.Err)
+		return
+	}
+	t.StateCurr.ElementInfo = bi.Info
+}
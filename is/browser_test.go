@@ -0,0 +1,106 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package is_test
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/J-Siu/go-is/v3/is/fixture"
+	"github.com/runZeroInc/go-rod/lib/launcher"
+)
+
+// Return a headless browser launched from local Chromium. Skip the test if none is found.
+func testBrowser(t *testing.T) *is.Browser {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("browser test skipped in short mode")
+	}
+	bin, ok := launcher.LookPath()
+	if !ok {
+		t.Skip("Chromium not found")
+	}
+	browser, err := is.NewBrowser(t.Context(), &is.BrowserProperty{Bin: bin, NoSandbox: os.Getuid() == 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { browser.Close() })
+	return browser
+}
+
+func TestRunFixture(t *testing.T) {
+	browser := testBrowser(t)
+	tests := []struct {
+		name     string
+		feed     fixture.Feed
+		property is.Property
+	}{
+		{"Append", fixture.Feed{Mode: fixture.ModeAppend, Items: 30},
+			is.Property{StopCond: &is.StopNoNewElements{N: 3}}},
+		{"LoadMore", fixture.Feed{Mode: fixture.ModeLoadMore, Items: 30},
+			is.Property{AdvanceMode: is.AdvanceClick, AdvanceSelector: fixture.SelectorMore}},
+		{"Sentinel", fixture.Feed{Mode: fixture.ModeAppend, Items: 30, Sentinel: true},
+			is.Property{StopCond: &is.StopSelector{Selector: fixture.SelectorEnd}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := fixture.New(&tt.feed)
+			defer srv.Close()
+			page, err := browser.NewPage("")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer browser.ClosePage(page)
+
+			property := tt.property
+			property.Page, property.UrlLoad, property.UrlStr, property.ScrollMax = page, true, srv.URL, -1
+			p := newTestProcessor(&property)
+			p.V020_Elements = func() { p.StateCurr.Elements, p.Err = p.Page.Elements(fixture.SelectorItem) }
+			p.V030_ElementInfo = func() {
+				id, err := p.StateCurr.Element.Attribute("data-id")
+				if err == nil && id != nil {
+					p.StateCurr.ElementInfo = &testInfo{User: *id}
+				}
+				p.Err = err
+			}
+			ctx, cancel := context.WithTimeout(t.Context(), time.Minute)
+			defer cancel()
+			p.RunContext(ctx)
+			if p.Err != nil {
+				t.Fatal(p.Err)
+			}
+			ids := users(p.IInfoList)
+			if len(ids) != tt.feed.Items {
+				t.Fatalf("%d infos, want %d: %v", len(ids), tt.feed.Items, ids)
+			}
+			for i, id := range ids {
+				if id != strconv.Itoa(i) {
+					t.Fatalf("info %d = %s, want %d", i, id, i)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package is
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Scroll iterations allowed after [Checkpoint.ScrollCount] to find [Checkpoint.LastKey] when resuming
+const CheckpointResumeMargin = 5
+
+// Checkpoint of a run, saved to [Property.CheckpointFile]
+//
+// [IInfoList] items must be registered with [RegisterInfo] to be loaded back.
+type Checkpoint struct {
+	Time        time.Time `json:"Time"`
+	UrlStr      string    `json:"UrlStr"`
+	ScrollCount int       `json:"ScrollCount"` // [State.ScrollCount] when saved
+	LastKey     string    `json:"LastKey"`     // Key of last processed element
+	Seen        []string  `json:"Seen"`        // Keys of [Processor.Seen]
+	IInfoList   IInfoList `json:"IInfoList"`   // Collected infos
+}
+
+// Load checkpoint from [file]
+func LoadCheckpoint(file string) (c *Checkpoint, err error) {
+	var b []byte
+	b, err = os.ReadFile(file)
+	if err == nil {
+		c = new(Checkpoint)
+		err = json.Unmarshal(b, c)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("LoadCheckpoint: %w", err)
+	}
+	return c, nil
+}
+
+// Save checkpoint to [file]. File is replaced atomically.
+func (c *Checkpoint) Save(file string) (err error) {
+	var b []byte
+	b, err = json.Marshal(c)
+	if err == nil {
+		err = writeFileAtomic(file, b)
+	}
+	if err != nil {
+		err = fmt.Errorf("Checkpoint.Save: %w", err)
+	}
+	return err
+}
+
+// Write [b] to a temp file in the same directory, then rename it to [file]
+func writeFileAtomic(file string, b []byte) (err error) {
+	var tmp *os.File
+	tmp, err = os.CreateTemp(filepath.Dir(file), filepath.Base(file)+".*.tmp")
+	if err == nil {
+		_, err = tmp.Write(b)
+		if errClose := tmp.Close(); err == nil {
+			err = errClose
+		}
+		if err == nil {
+			err = os.Rename(tmp.Name(), file)
+		}
+		if err != nil {
+			os.Remove(tmp.Name())
+		}
+	}
+	return err
+}
+
+// Save checkpoint of current run to [CheckpointFile]
+func (t *Processor) checkpoint() {
+	prefix := t.MyType + ".Checkpoint"
+	t.StateCurr.Name = prefix
+	c := &Checkpoint{
+		Time:        time.Now(),
+		UrlStr:      t.UrlStr,
+		ScrollCount: t.StateCurr.ScrollCount,
+		LastKey:     t.lastKey,
+		Seen:        make([]string, 0, len(t.Seen)),
+	}
+	for key := range t.Seen {
+		c.Seen = append(c.Seen, key)
+	}
+	slices.Sort(c.Seen)
+	if t.IInfoList != nil {
+		c.IInfoList = *t.IInfoList
+	}
+	if err := c.Save(t.CheckpointFile); err != nil {
+		t.Err = fmt.Errorf("%s: %w", prefix, err)
+	} else if t.Logger != nil {
+		t.Logger.Debug().N(prefix).N(t.CheckpointFile).M(len(c.Seen)).Out()
+	}
+}
+
+// Remove [CheckpointFile] after a clean run. A missing file is not an error.
+func (t *Processor) checkpointRemove() {
+	prefix := t.MyType + ".Checkpoint"
+	err := os.Remove(t.CheckpointFile)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		t.Err = fmt.Errorf("%s: %w", prefix, err)
+	} else if t.Logger != nil {
+		t.Logger.Debug().N(prefix).N("removed").M(t.CheckpointFile).Out()
+	}
+}
+
+// Load [CheckpointFile] if it exists, restoring [Seen] and [IInfoList]
+//
+// A checkpoint saved for another [UrlStr] is an error, and the file is kept.
+// The scroll loop then fast-forwards, ignoring [ScrollMax] and [StopCond], until the last processed element is found,
+// or for at most [Checkpoint.ScrollCount] + [CheckpointResumeMargin] iterations.
+func (t *Processor) resume() {
+	prefix := t.MyType + ".Resume"
+	t.StateCurr.Name = prefix
+	c, err := LoadCheckpoint(t.CheckpointFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		if t.Logger != nil {
+			t.Logger.Debug().N(prefix).N("no checkpoint").M(t.CheckpointFile).Out()
+		}
+		return
+	}
+	if err == nil && c.UrlStr != t.UrlStr {
+		err = errors.New("checkpoint of " + strconv.Quote(c.UrlStr) + " != UrlStr " + strconv.Quote(t.UrlStr))
+	}
+	if err != nil {
+		t.Err = fmt.Errorf("%s: %w", prefix, err)
+		return
+	}
+	for _, key := range c.Seen {
+		t.Seen[key] = true
+	}
+	if t.IInfoList != nil {
+		*t.IInfoList = c.IInfoList
+	}
+	t.lastKey = c.LastKey
+	t.resumeKey = c.LastKey
+	t.resumeMax = c.ScrollCount + CheckpointResumeMargin
+	if t.Logger != nil {
+		t.Logger.Debug().N(prefix).N("seen").M(len(c.Seen)).N("last").M(c.LastKey).Out()
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package is
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Action to take when a stage of [Processor.Run] fails
+type ErrPolicy int8
+
+const (
+	ErrPolicyAbort ErrPolicy = iota // Stop the run. [StageError] is recorded in [Processor.Err]. (default)
+	ErrPolicySkip                   // Skip the rest of current element, or ignore the failed stage outside element loop
+	ErrPolicyRetry                  // Run the stage again, up to [Property.ErrRetryMax] times, then skip
+)
+
+func (p ErrPolicy) String() string {
+	switch p {
+	case ErrPolicyAbort:
+		return "Abort"
+	case ErrPolicySkip:
+		return "Skip"
+	case ErrPolicyRetry:
+		return "Retry"
+	}
+	return "ErrPolicy(" + strconv.Itoa(int(p)) + ")"
+}
+
+// Error of a [Processor] stage (field function)
+//
+// Created by [Processor.funcWrapper] when a stage panics, or sets [Processor.Err].
+type StageError struct {
+	Stage        string `json:"Stage"`        // Stage name. eg. `V030`, `ScrollElement`
+	ElementIndex int    `json:"ElementIndex"` // [State.ElementIndex] when error occurred. -1 if outside element loop
+	ScrollCount  int    `json:"ScrollCount"`  // [State.ScrollCount] when error occurred
+	Attempt      int    `json:"Attempt"`      // 1 for first run, increased on each retry
+	Panic        any    `json:"Panic"`        // Recovered panic value. `nil` if stage did not panic
+	Err          error  `json:"Err"`          // Underlying error
+}
+
+func (e *StageError) Error() string {
+	str := e.Stage + ": scroll " + strconv.Itoa(e.ScrollCount)
+	if e.ElementIndex >= 0 {
+		str += ", element " + strconv.Itoa(e.ElementIndex)
+	}
+	if e.Panic != nil {
+		str += ", panic"
+	}
+	if e.Err != nil {
+		str += ": " + e.Err.Error()
+	}
+	return str
+}
+
+func (e *StageError) Unwrap() error { return e.Err }
+
+// JSON form of [StageError], with [StageError.Panic] and [StageError.Err] as text
+type stageErrorJSON struct {
+	Stage        string `json:"Stage"`
+	ElementIndex int    `json:"ElementIndex"`
+	ScrollCount  int    `json:"ScrollCount"`
+	Attempt      int    `json:"Attempt"`
+	Panic        string `json:"Panic,omitempty"`
+	Err          string `json:"Err,omitempty"`
+}
+
+func (e *StageError) toJSON() (j stageErrorJSON) {
+	if e == nil {
+		return j
+	}
+	j = stageErrorJSON{
+		Stage:        e.Stage,
+		ElementIndex: e.ElementIndex,
+		ScrollCount:  e.ScrollCount,
+		Attempt:      e.Attempt,
+		Err:          errStr(e.Err),
+	}
+	if e.Panic != nil {
+		j.Panic = fmt.Sprint(e.Panic)
+	}
+	return j
+}
+
+// Marshal [StageError.Panic] and [StageError.Err] as text, as error values marshal to `{}`
+func (e *StageError) MarshalJSON() ([]byte, error) { return json.Marshal(e.toJSON()) }
+
+// Return err.Error(), or "" if [err] is nil
+func errStr(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// Convert recovered panic value [r] into an error
+func panicErr(r any) error {
+	switch v := r.(type) {
+	case error:
+		return v
+	case string:
+		return errors.New(v)
+	}
+	return fmt.Errorf("%v", r)
+}
+
+// Return true if [err] is caused by context cancellation or deadline
+func isCtxErr(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,366 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+// Package [extract] fills info structs from [is.IElement] using struct tags.
+//
+// Field tag `is` is a list of directives separated by `;`:
+//
+//	css=<selector>  find sub element(s) with css selector. Without it, the element itself is used
+//	text            inner text (default)
+//	html            outer HTML
+//	attr=<name>     attribute value
+//	prop=<name>     property value
+//	abs             resolve value as URL relative to the page
+//	regex=<expr>    keep first capture group (or whole match) of regular expression
+//	layout=<layout> [time.Parse] layout for [time.Time] fields. (default: [time.RFC3339])
+//	optional        no error if css selector has no match
+//
+// Field types: string, bool, int*, uint*, float*, [time.Time], pointer of them, slice of them (all css matches),
+// and struct (css scopes the nested struct fields). Fields without `is` tag are skipped.
+// A pointer field is left `nil` if its attribute does not exist, or its optional css selector has no match.
+//
+//	type XFeedInfo struct {
+//		is.InfoBase
+//		User string   `is:"css=[data-testid='User-Name'] a;text;optional"`
+//		Text string   `is:"css=[data-testid='tweetText'];text;optional"`
+//		Link string   `is:"css=a:has(time);attr=href;abs;optional"`
+//		Time time.Time `is:"css=time;attr=datetime;optional"`
+//	}
+//
+//	p.V030_ElementInfo = extract.V030[XFeedInfo](p.Processor)
+package extract
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/runZeroInc/go-rod/pkg/gson"
+)
+
+// Struct tag key
+const TagKey = "is"
+
+// Parsed `is` tag of a field
+type Tag struct {
+	Css      string // css selector
+	Source   string // "text", "html", "attr" or "prop"
+	Name     string // attribute/property name
+	Abs      bool
+	Regex    *regexp.Regexp
+	Layout   string
+	Optional bool
+}
+
+// Cache of parsed tags per struct type
+var tagCache sync.Map // reflect.Type -> []fieldTag
+
+type fieldTag struct {
+	index int
+	tag   *Tag
+}
+
+// Return a field function for [is.Processor.V030_ElementInfo], filling a new `T` from [StateCurr.Element]
+//
+// `*T` must implement [is.IInfo]. eg. `extract.V030[XFeedInfo](p.Processor)`
+func V030[T any, PT interface {
+	*T
+	is.IInfo
+}](p *is.Processor) is.ProcessorFunc {
+	return func() {
+		prefix := p.MyType + ".V030_ElementInfo" + "(extract)"
+		p.StateCurr.Name = prefix
+		p.StateCurr.ElementInfo = nil
+		info := PT(new(T))
+		if err := Extract(p.StateCurr.Element, info); err != nil {
+			p.Err = errors.New(prefix + ": " + err.Error())
+			return
+		}
+		p.StateCurr.ElementInfo = info
+		if p.Logger != nil {
+			p.Logger.Trace().N(prefix).M(info).Out()
+		}
+	}
+}
+
+// Fill struct pointed by [v] from [el] using `is` field tags
+func Extract(el is.IElement, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("extract: v must be a non-nil pointer to struct")
+	}
+	return extractStruct(el, rv.Elem())
+}
+
+// Parse `is` tag
+func ParseTag(str string) (tag *Tag, err error) {
+	tag = &Tag{Source: "text", Layout: time.RFC3339}
+	for _, part := range splitTag(str) {
+		key, val, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "":
+		case "css":
+			tag.Css = val
+		case "text", "html":
+			tag.Source = key
+		case "attr", "prop":
+			tag.Source, tag.Name = key, val
+		case "abs":
+			tag.Abs = true
+		case "regex":
+			tag.Regex, err = regexp.Compile(val)
+		case "layout":
+			tag.Layout = val
+		case "optional":
+			tag.Optional = true
+		default:
+			err = errors.New("unknown directive: " + key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("tag %q: %w", str, err)
+		}
+	}
+	return tag, nil
+}
+
+// Split tag by `;`, ignoring those inside quotes and brackets of css selector
+func splitTag(str string) (parts []string) {
+	var (
+		depth int
+		quote rune
+		start int
+	)
+	for i, r := range str {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '[' || r == '(':
+			depth++
+		case r == ']' || r == ')':
+			depth--
+		case r == ';' && depth == 0:
+			parts = append(parts, str[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, str[start:])
+}
+
+// Return parsed tags of struct type [typ]
+func fieldTags(typ reflect.Type) ([]fieldTag, error) {
+	if v, ok := tagCache.Load(typ); ok {
+		return v.([]fieldTag), nil
+	}
+	var tags []fieldTag
+	for i := range typ.NumField() {
+		sf := typ.Field(i)
+		str, ok := sf.Tag.Lookup(TagKey)
+		if !ok || !sf.IsExported() {
+			continue
+		}
+		tag, err := ParseTag(str)
+		if err != nil {
+			return nil, errors.New(typ.String() + "." + sf.Name + ": " + err.Error())
+		}
+		tags = append(tags, fieldTag{index: i, tag: tag})
+	}
+	tagCache.Store(typ, tags)
+	return tags, nil
+}
+
+func extractStruct(el is.IElement, v reflect.Value) error {
+	tags, err := fieldTags(v.Type())
+	if err != nil {
+		return err
+	}
+	for _, ft := range tags {
+		if err = extractField(el, ft.tag, v.Field(ft.index)); err != nil {
+			return errors.New(v.Type().Field(ft.index).Name + ": " + err.Error())
+		}
+	}
+	return nil
+}
+
+func extractField(el is.IElement, tag *Tag, f reflect.Value) (err error) {
+	var els []is.IElement
+	if tag.Css == "" {
+		els = []is.IElement{el}
+	} else if els, err = el.Elements(tag.Css); err != nil {
+		return err
+	}
+	if len(els) == 0 {
+		if tag.Optional || f.Kind() == reflect.Slice {
+			return nil
+		}
+		return errors.New("no match: " + tag.Css)
+	}
+	if f.Kind() == reflect.Slice && f.Type().Elem().Kind() != reflect.Uint8 {
+		list := reflect.MakeSlice(f.Type(), len(els), len(els))
+		for i, e := range els {
+			if err = extractValue(e, tag, list.Index(i)); err != nil {
+				return fmt.Errorf("[%d]: %w", i, err)
+			}
+		}
+		f.Set(list)
+		return nil
+	}
+	return extractValue(els[0], tag, f)
+}
+
+// Fill single value [f] from [el]. A pointer [f] is left `nil` if the attribute does not exist.
+func extractValue(el is.IElement, tag *Tag, f reflect.Value) error {
+	typ := f.Type()
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	v := f
+	if f.Kind() == reflect.Pointer {
+		v = reflect.New(typ).Elem()
+	}
+	if typ.Kind() == reflect.Struct && typ != reflect.TypeFor[time.Time]() {
+		if err := extractStruct(el, v); err != nil {
+			return err
+		}
+	} else {
+		str, ok, err := source(el, tag)
+		if err != nil || !ok {
+			return err
+		}
+		if err = convert(str, tag, v); err != nil {
+			return err
+		}
+	}
+	if f.Kind() == reflect.Pointer {
+		f.Set(v.Addr())
+	}
+	return nil
+}
+
+// Return value of [tag.Source] from [el]. [ok] is false if attribute does not exist.
+func source(el is.IElement, tag *Tag) (str string, ok bool, err error) {
+	switch tag.Source {
+	case "html":
+		str, err = el.HTML()
+	case "attr":
+		var attr *string
+		if attr, err = el.Attribute(tag.Name); err == nil && attr != nil {
+			str = *attr
+		} else if err == nil {
+			return "", false, nil
+		}
+	case "prop":
+		var res gson.JSON
+		if res, err = el.Property(tag.Name); err == nil {
+			str = res.Str()
+		}
+	default:
+		str, err = el.Text()
+	}
+	if err == nil && tag.Abs && str != "" {
+		str, err = absURL(el, str)
+	}
+	if err == nil && tag.Regex != nil {
+		m := tag.Regex.FindStringSubmatch(str)
+		switch {
+		case m == nil:
+			str = ""
+		case len(m) > 1:
+			str = m[1]
+		default:
+			str = m[0]
+		}
+	}
+	return strings.TrimSpace(str), err == nil, err
+}
+
+// Resolve [str] against base URI of [el]
+func absURL(el is.IElement, str string) (string, error) {
+	res, err := el.Property("baseURI")
+	var base, ref *url.URL
+	if err == nil {
+		base, err = url.Parse(res.Str())
+	}
+	if err == nil {
+		ref, err = url.Parse(str)
+	}
+	if err != nil {
+		return "", err
+	}
+	return base.ResolveReference(ref).String(), nil
+}
+
+// Convert [str] into [f] base on its type
+func convert(str string, tag *Tag, f reflect.Value) (err error) {
+	if f.Type() == reflect.TypeFor[time.Time]() {
+		if str == "" {
+			return nil
+		}
+		var tm time.Time
+		if tm, err = time.Parse(tag.Layout, str); err == nil {
+			f.Set(reflect.ValueOf(tm))
+		}
+		return err
+	}
+	num := strings.NewReplacer(",", "", " ", "", "_", "").Replace(str)
+	switch f.Kind() {
+	case reflect.String:
+		f.SetString(str)
+	case reflect.Bool:
+		var b bool
+		if str != "" {
+			b, err = strconv.ParseBool(str)
+		}
+		f.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		if num != "" {
+			i, err = strconv.ParseInt(num, 10, f.Type().Bits())
+		}
+		f.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var u uint64
+		if num != "" {
+			u, err = strconv.ParseUint(num, 10, f.Type().Bits())
+		}
+		f.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		var fl float64
+		if num != "" {
+			fl, err = strconv.ParseFloat(num, f.Type().Bits())
+		}
+		f.SetFloat(fl)
+	default:
+		err = errors.New("unsupported type: " + f.Type().String())
+	}
+	return err
+}
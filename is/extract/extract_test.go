@@ -0,0 +1,225 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package extract
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/J-Siu/go-is/v3/is/fake"
+)
+
+func TestSplitTag(t *testing.T) {
+	tests := []struct {
+		str  string
+		want []string
+	}{
+		{"", []string{""}},
+		{"text", []string{"text"}},
+		{"css=a;attr=href;abs", []string{"css=a", "attr=href", "abs"}},
+		{"css=[data-x='a;b'];text", []string{"css=[data-x='a;b']", "text"}},
+		{`css=a[title="x;y"];optional`, []string{`css=a[title="x;y"]`, "optional"}},
+		{"css=div:not(.a;.b);html", []string{"css=div:not(.a;.b)", "html"}},
+		{"regex=(\\d+);text", []string{"regex=(\\d+)", "text"}},
+	}
+	for _, tt := range tests {
+		if got := splitTag(tt.str); !slices.Equal(got, tt.want) {
+			t.Errorf("splitTag(%q) = %q, want %q", tt.str, got, tt.want)
+		}
+	}
+}
+
+func TestParseTag(t *testing.T) {
+	tag, err := ParseTag("css=a:has(time); attr=href ;abs;regex=/(\\d+)$;layout=2006-01-02;optional")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tag.Css != "a:has(time)" || tag.Source != "attr" || tag.Name != "href" || !tag.Abs || tag.Regex == nil || tag.Layout != "2006-01-02" || !tag.Optional {
+		t.Errorf("ParseTag() = %+v", tag)
+	}
+	for _, str := range []string{"bogus", "regex=("} {
+		if _, err = ParseTag(str); err == nil {
+			t.Errorf("ParseTag(%q) = nil error", str)
+		}
+	}
+}
+
+type convInfo struct {
+	is.InfoBase
+	Str   string    `is:"text"`
+	Bool  bool      `is:"attr=data-bool"`
+	Int   int       `is:"attr=data-int"`
+	Int8  int8      `is:"attr=data-int8"`
+	Uint  uint16    `is:"attr=data-uint"`
+	Float float64   `is:"attr=data-float"`
+	Time  time.Time `is:"attr=datetime"`
+	Date  time.Time `is:"attr=data-date;layout=2006-01-02"`
+	Prop  string    `is:"prop=value"`
+	HTML  string    `is:"html"`
+	Abs   string    `is:"attr=href;abs"`
+	Re    int       `is:"attr=href;regex=/(\\d+)$"`
+	Skip  string
+}
+
+func TestConvert(t *testing.T) {
+	el := &fake.Element{
+		InnerText: "  hello  ",
+		OuterHTML: "<article>hello</article>",
+		Attrs: map[string]string{
+			"data-bool":  "true",
+			"data-int":   "1,234",
+			"data-int8":  "-8",
+			"data-uint":  "65_535",
+			"data-float": "1.5",
+			"datetime":   "2026-01-02T03:04:05Z",
+			"data-date":  "2026-01-02",
+			"href":       "/status/42",
+		},
+		Props: map[string]any{"value": "v", "baseURI": "https://x.com/home"},
+	}
+	var info convInfo
+	if err := Extract(el, &info); err != nil {
+		t.Fatal(err)
+	}
+	want := convInfo{
+		Str:   "hello",
+		Bool:  true,
+		Int:   1234,
+		Int8:  -8,
+		Uint:  65535,
+		Float: 1.5,
+		Time:  time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Date:  time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+		Prop:  "v",
+		HTML:  "<article>hello</article>",
+		Abs:   "https://x.com/status/42",
+		Re:    42,
+	}
+	if !reflect.DeepEqual(info, want) {
+		t.Errorf("Extract() =\n%+v\nwant\n%+v", info, want)
+	}
+
+	tests := []struct {
+		name string
+		v    any
+		want string
+	}{
+		{"bad int", &struct {
+			N int `is:"text"`
+		}{}, "invalid syntax"},
+		{"int8 overflow", &struct {
+			N int8 `is:"attr=n"`
+		}{}, "out of range"},
+		{"bad bool", &struct {
+			B bool `is:"text"`
+		}{}, "invalid syntax"},
+		{"bad time", &struct {
+			T time.Time `is:"text"`
+		}{}, "cannot parse"},
+		{"unsupported", &struct {
+			M map[string]string `is:"text"`
+		}{}, "unsupported type"},
+		{"not struct", new(string), "non-nil pointer to struct"},
+	}
+	bad := &fake.Element{InnerText: "x", Attrs: map[string]string{"n": "300"}}
+	for _, tt := range tests {
+		if err := Extract(bad, tt.v); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: Extract() = %v, want %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+type author struct {
+	Name string `is:"css=.name"`
+	Url  string `is:"css=a;attr=href;optional"`
+}
+
+type postInfo struct {
+	is.InfoBase
+	User    string     `is:"css=.user"`
+	Missing string     `is:"css=.missing;optional"`
+	Tags    []string   `is:"css=.tag"`
+	Likes   []int      `is:"css=.like;attr=data-n"`
+	Author  author     `is:"css=.author"`
+	Editor  *author    `is:"css=.editor;optional"`
+	Coauth  []author   `is:"css=.coauthor"`
+	Views   *int       `is:"attr=data-views"`
+	Shares  *int       `is:"attr=data-shares"`
+	Edited  *string    `is:"css=.edited;optional"`
+	Pinned  *bool      `is:"css=.pin;attr=data-pinned;optional"`
+	Updated *time.Time `is:"attr=data-updated"`
+}
+
+func TestExtract(t *testing.T) {
+	text := func(s string) *fake.Element { return &fake.Element{InnerText: s} }
+	el := &fake.Element{
+		Attrs: map[string]string{"data-views": "7"},
+		Children: map[string][]*fake.Element{
+			".user": {text("alice"), text("ignored")},
+			".tag":  {text("go"), text("rod")},
+			".like": {{Attrs: map[string]string{"data-n": "1"}}, {Attrs: map[string]string{"data-n": "2"}}},
+			".author": {{Children: map[string][]*fake.Element{
+				".name": {text("Al")},
+				"a":     {{Attrs: map[string]string{"href": "/al"}}},
+			}}},
+			".coauthor": {
+				{Children: map[string][]*fake.Element{".name": {text("Bo")}}},
+				{Children: map[string][]*fake.Element{".name": {text("Cy")}}},
+			},
+			".pin": {text("")},
+		},
+	}
+	var info postInfo
+	if err := Extract(el, &info); err != nil {
+		t.Fatal(err)
+	}
+	if info.User != "alice" || info.Missing != "" {
+		t.Errorf("User = %q, Missing = %q", info.User, info.Missing)
+	}
+	if !slices.Equal(info.Tags, []string{"go", "rod"}) || !slices.Equal(info.Likes, []int{1, 2}) {
+		t.Errorf("Tags = %v, Likes = %v", info.Tags, info.Likes)
+	}
+	if info.Author != (author{Name: "Al", Url: "/al"}) {
+		t.Errorf("Author = %+v", info.Author)
+	}
+	if want := []author{{Name: "Bo"}, {Name: "Cy"}}; !slices.Equal(info.Coauth, want) {
+		t.Errorf("Coauth = %+v, want %+v", info.Coauth, want)
+	}
+	if info.Views == nil || *info.Views != 7 {
+		t.Errorf("Views = %v, want 7", info.Views)
+	}
+	// pointers of missing attribute or optional css without match are left nil
+	if info.Editor != nil || info.Shares != nil || info.Edited != nil || info.Pinned != nil || info.Updated != nil {
+		t.Errorf("Editor = %v, Shares = %v, Edited = %v, Pinned = %v, Updated = %v, want all nil",
+			info.Editor, info.Shares, info.Edited, info.Pinned, info.Updated)
+	}
+
+	// required css without match
+	delete(el.Children, ".user")
+	if err := Extract(el, &postInfo{}); err == nil || !strings.Contains(err.Error(), "User: no match: .user") {
+		t.Errorf("Extract() = %v, want no match error of User", err)
+	}
+}
@@ -0,0 +1,299 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+// Package [fake] provides an in-memory [is.IPage] with scripted DOM snapshots,
+// so the whole [is.Processor.Run] loop can be tested without a browser.
+//
+//	a, b, c := &fake.Element{Key: "a"}, &fake.Element{Key: "b"}, &fake.Element{Key: "c"}
+//	page := fake.New(
+//		fake.Snapshot{"article": {a, b}},    // after load
+//		fake.Snapshot{"article": {a, b, c}}, // after 1st scroll
+//	)
+//	p := is.New(&is.Property{Page: page, ScrollMax: -1, StopCond: &is.StopNoNewElements{N: 1}})
+//
+// Each scroll, click or navigation after the first [Page.Navigate] moves the page to the next snapshot.
+// Once the last snapshot is reached, the page stays there and scroll height stops growing.
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/runZeroInc/go-rod/pkg/gson"
+)
+
+// Returned when no element matches a selector
+var ErrNotFound = errors.New("fake: element not found")
+
+// DOM of a page at one point in time. Css selector -> matching elements
+type Snapshot map[string][]*Element
+
+// In-memory [is.IPage]
+type Page struct {
+	*Script // Shared by copies of the page returned by [Page.Context]
+
+	ctx context.Context
+}
+
+// Snapshots and progress of a [Page]
+type Script struct {
+	Snapshots []Snapshot // DOM after load, and after each scroll/click/navigation
+	Index     int        // Index of current snapshot
+	URLs      []string   // URLs navigated, in order
+	Scrolls   int        // Number of scrolls, clicks and navigations after load
+}
+
+// Element of a [Snapshot]
+type Element struct {
+	Key       string                // Returned by [Page.Keys]. (default: [InnerText])
+	Detached  bool                  // Element is detached from DOM. Key is empty.
+	InnerText string                // Returned by [Element.Text]
+	OuterHTML string                // Returned by [Element.HTML]
+	Attrs     map[string]string     // Returned by [Element.Attribute]
+	Props     map[string]any        // Returned by [Element.Property], fall back to [Attrs]
+	Children  map[string][]*Element // Css selector -> matching children
+	Data      any                   // Returned, as JSON, by [Page.ExtractJS]. Use a map or struct.
+	Disabled  bool                  // [Element.WaitEnabled] times out and [Element.Click] fails if true
+	Clicks    int                   // Number of clicks
+
+	page *Page
+}
+
+// Return a page with [snapshots]
+func New(snapshots ...Snapshot) *Page {
+	return &Page{Script: &Script{Snapshots: snapshots}}
+}
+
+// Return current snapshot
+func (p *Script) Snapshot() Snapshot {
+	if len(p.Snapshots) == 0 {
+		return Snapshot{}
+	}
+	return p.Snapshots[p.Index]
+}
+
+// Move to next snapshot, if any
+func (p *Script) Next() {
+	p.Scrolls++
+	if p.Index < len(p.Snapshots)-1 {
+		p.Index++
+	}
+}
+
+// Return elements of current snapshot matching [selector], with page set
+func (p *Page) elements(selector string) []*Element {
+	es := p.Snapshot()[selector]
+	for _, e := range es {
+		e.page = p
+	}
+	return es
+}
+
+func (p *Page) Context(ctx context.Context) is.IPage { return &Page{Script: p.Script, ctx: ctx} }
+
+func (p *Page) GetContext() context.Context {
+	if p.ctx == nil {
+		return context.Background()
+	}
+	return p.ctx
+}
+
+// Record [url]. Move to next snapshot, except for the first navigation, which loads the page.
+func (p *Page) Navigate(url string) error {
+	if err := p.GetContext().Err(); err != nil {
+		return err
+	}
+	if len(p.URLs) > 0 {
+		p.Next()
+	}
+	p.URLs = append(p.URLs, url)
+	return nil
+}
+
+func (p *Page) Element(selector string) (is.IElement, error) {
+	found, e, err := p.Has(selector)
+	if err == nil && !found {
+		err = errors.New(ErrNotFound.Error() + ": " + selector)
+	}
+	return e, err
+}
+
+func (p *Page) Elements(selector string) ([]is.IElement, error) {
+	return list(p.elements(selector)), p.GetContext().Err()
+}
+
+func (p *Page) Has(selector string) (bool, is.IElement, error) {
+	if es := p.elements(selector); len(es) > 0 {
+		return true, es[0], nil
+	}
+	return false, nil, p.GetContext().Err()
+}
+
+// Return [Element.Key], or [Element.InnerText] if empty. Detached element has an empty key.
+func (p *Page) Keys(elements []is.IElement) (keys []string, err error) {
+	keys = make([]string, len(elements))
+	for i, ie := range elements {
+		e, ok := ie.(*Element)
+		if !ok {
+			return nil, errors.New("fake: not a fake element")
+		}
+		switch {
+		case e.Detached:
+		case e.Key != "":
+			keys[i] = e.Key
+		default:
+			keys[i] = e.InnerText
+		}
+	}
+	return keys, nil
+}
+
+// Return [Element.Data] of each element as JSON. [fn] is ignored. Detached element returns `null`.
+func (p *Page) ExtractJS(fn string, elements []is.IElement) (items []json.RawMessage, err error) {
+	items = make([]json.RawMessage, len(elements))
+	for i, ie := range elements {
+		e, ok := ie.(*Element)
+		if !ok {
+			return nil, errors.New("fake: not a fake element")
+		}
+		var data any
+		if !e.Detached {
+			data = e.Data
+		}
+		if items[i], err = json.Marshal(data); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// Move to next snapshot unless [mode] is [is.ScrollModeElement]. Return index of current snapshot + 1 as height.
+func (p *Page) Scroll(container is.IElement, mode is.ScrollMode, step float64, pixels int, dir is.ScrollDirection) (height int, err error) {
+	if err = p.GetContext().Err(); err == nil {
+		if mode != is.ScrollModeElement {
+			p.Next()
+		}
+		height = p.Index + 1
+	}
+	return height, err
+}
+
+func (p *Page) WaitDOMStable(d time.Duration, diff float64) error { return p.GetContext().Err() }
+func (p *Page) WaitIdle(d time.Duration) error                    { return p.GetContext().Err() }
+
+// -- Element
+
+// Return [es] as [is.IElement]s
+func list(es []*Element) []is.IElement {
+	elements := make([]is.IElement, len(es))
+	for i, e := range es {
+		elements[i] = e
+	}
+	return elements
+}
+
+// Return error if [e] is detached, or context of its page is done
+func (e *Element) check() error {
+	if e.Detached {
+		return errors.New("fake: element is detached")
+	}
+	if e.page != nil {
+		return e.page.GetContext().Err()
+	}
+	return nil
+}
+
+// Return [e]. Elements share page state, so context of [e.page] applies.
+func (e *Element) Context(ctx context.Context) is.IElement { return e }
+
+func (e *Element) Element(selector string) (is.IElement, error) {
+	es := e.Children[selector]
+	if len(es) == 0 {
+		return nil, errors.New(ErrNotFound.Error() + ": " + selector)
+	}
+	es[0].page = e.page
+	return es[0], e.check()
+}
+
+func (e *Element) Elements(selector string) ([]is.IElement, error) {
+	es := e.Children[selector]
+	for _, c := range es {
+		c.page = e.page
+	}
+	return list(es), e.check()
+}
+
+func (e *Element) Text() (string, error) { return e.InnerText, e.check() }
+func (e *Element) HTML() (string, error) { return e.OuterHTML, e.check() }
+
+func (e *Element) Attribute(name string) (*string, error) {
+	if v, ok := e.Attrs[name]; ok {
+		return &v, e.check()
+	}
+	return nil, e.check()
+}
+
+func (e *Element) Property(name string) (gson.JSON, error) {
+	if v, ok := e.Props[name]; ok {
+		return gson.New(v), e.check()
+	}
+	if v, ok := e.Attrs[name]; ok {
+		return gson.New(v), e.check()
+	}
+	return gson.New(nil), e.check()
+}
+
+// Move page to next snapshot
+func (e *Element) ScrollIntoView() error {
+	err := e.check()
+	if err == nil && e.page != nil {
+		e.page.Next()
+	}
+	return err
+}
+
+// Return [context.DeadlineExceeded] if [Element.Disabled], as if the wait timed out
+func (e *Element) WaitEnabled() error {
+	err := e.check()
+	if err == nil && e.Disabled {
+		err = context.DeadlineExceeded
+	}
+	return err
+}
+
+// Count the click and move page to next snapshot
+func (e *Element) Click() error {
+	err := e.check()
+	if err == nil && e.Disabled {
+		err = errors.New("fake: element is disabled")
+	}
+	if err == nil {
+		e.Clicks++
+		if e.page != nil {
+			e.page.Next()
+		}
+	}
+	return err
+}
@@ -0,0 +1,309 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+// Package [fixture] serves configurable infinite scroll pages over [httptest], as an offline target
+// for testing processors with a local headless browser.
+//
+//	srv := fixture.New(&fixture.Feed{Mode: fixture.ModeAppend, Items: 50, Sentinel: true})
+//	defer srv.Close()
+//	property := is.Property{Page: page, UrlLoad: true, UrlStr: srv.URL, StopCond: &is.StopSelector{Selector: fixture.SelectorEnd}}
+//
+// Items are `<article class="item" id="item-N" data-id="N">`, numbered from 0 (oldest) to [Feed.Items]-1.
+package fixture
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// Css selectors of the page
+const (
+	SelectorItem      = "article.item" // Feed item
+	SelectorContainer = "#feed"        // Parent of items. Scrollable if [Feed.Nested]
+	SelectorMore      = "#more"        // Load more button of [ModeLoadMore]. Removed at end of feed
+	SelectorEnd       = "#end"         // End of feed sentinel, if [Feed.Sentinel]
+	SelectorError     = "#error"       // Shown when a load fails
+)
+
+// How the feed grows
+type Mode int8
+
+const (
+	ModeAppend   Mode = iota // Items are appended at the bottom when scrolled near the bottom (default)
+	ModePrepend              // Older items are prepended at the top when scrolled near the top. Page starts at the bottom
+	ModeVirtual              // As [ModeAppend], but only [Feed.Window] items are kept in DOM. Items scrolled out are removed
+	ModeLoadMore             // Items are appended when [SelectorMore] button is clicked
+)
+
+// Feed configuration
+type Feed struct {
+	Mode      Mode          `json:"Mode"`
+	Items     int           `json:"Items"`    // Total number of items. (default: 100)
+	PageSize  int           `json:"PageSize"` // Number of items per load. (default: 10)
+	Window    int           `json:"Window"`   // [ModeVirtual]: number of items kept in DOM. (default: 2 * PageSize)
+	Nested    bool          `json:"Nested"`   // Items are in a scrollable [SelectorContainer], instead of the window
+	Delay     time.Duration `json:"-"`        // Delay of each load
+	FailEvery int           `json:"-"`        // Every Nth load fails with status 500. Same items are loaded again on next scroll/click
+	Sentinel  bool          `json:"Sentinel"` // Add [SelectorEnd] after the last item
+}
+
+// Feed item, returned by `/items` as JSON
+type Item struct {
+	Id    int    `json:"Id"`
+	Title string `json:"Title"`
+	Text  string `json:"Text"`
+}
+
+// Return item [id]
+func NewItem(id int) Item {
+	return Item{Id: id, Title: "Item " + strconv.Itoa(id), Text: "Text of item " + strconv.Itoa(id)}
+}
+
+// Fixture server
+type Server struct {
+	*httptest.Server
+	Feed *Feed
+
+	loads atomic.Int64
+	fails atomic.Int64
+}
+
+// Start a server for [feed]. Call [Server.Close] when done.
+func New(feed *Feed) *Server {
+	s := &Server{Feed: feed.defaults()}
+	s.Server = httptest.NewServer(s.Handler())
+	return s
+}
+
+// Return number of loads, excluding the initial page
+func (s *Server) Loads() int { return int(s.loads.Load()) }
+
+// Return number of failed loads
+func (s *Server) Fails() int { return int(s.fails.Load()) }
+
+// Return handler serving the page at `/` and items at `/items?from=N&to=M`
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", s.page)
+	mux.HandleFunc("GET /items", s.items)
+	mux.HandleFunc("GET /item/{id}", s.item)
+	return mux
+}
+
+// Return copy of [f] with defaults applied
+func (f *Feed) defaults() *Feed {
+	c := *f
+	if c.Items <= 0 {
+		c.Items = 100
+	}
+	if c.PageSize <= 0 {
+		c.PageSize = 10
+	}
+	if c.Window <= 0 {
+		c.Window = 2 * c.PageSize
+	}
+	return &c
+}
+
+// Return item range [from, to) rendered with the initial page
+func (f *Feed) first() (from, to int) {
+	from, to = 0, min(f.PageSize, f.Items)
+	if f.Mode == ModePrepend {
+		from, to = max(0, f.Items-f.PageSize), f.Items
+	}
+	return from, to
+}
+
+func (s *Server) page(w http.ResponseWriter, r *http.Request) {
+	from, to := s.Feed.first()
+	data := struct {
+		Feed  *Feed
+		Items []Item
+		From  int
+		To    int
+	}{Feed: s.Feed, From: from, To: to}
+	for i := from; i < to; i++ {
+		data.Items = append(data.Items, NewItem(i))
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pageTmpl.Execute(w, data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) items(w http.ResponseWriter, r *http.Request) {
+	n := s.loads.Add(1)
+	if s.Feed.Delay > 0 {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(s.Feed.Delay):
+		}
+	}
+	if s.Feed.FailEvery > 0 && n%int64(s.Feed.FailEvery) == 0 {
+		s.fails.Add(1)
+		http.Error(w, "load failed", http.StatusInternalServerError)
+		return
+	}
+	from, errFrom := strconv.Atoi(r.URL.Query().Get("from"))
+	to, errTo := strconv.Atoi(r.URL.Query().Get("to"))
+	if errFrom != nil || errTo != nil {
+		http.Error(w, "invalid range", http.StatusBadRequest)
+		return
+	}
+	items := []Item{}
+	for i := max(from, 0); i < min(to, s.Feed.Items); i++ {
+		items = append(items, NewItem(i))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(items)
+}
+
+func (s *Server) item(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil || id < 0 || id >= s.Feed.Items {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(NewItem(id))
+}
+
+var pageTmpl = template.Must(template.New("page").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Feed</title>
+<style>
+body { margin: 0; font-family: sans-serif }
+#feed { margin: 0 auto; width: 600px }
+#feed.nested { height: 600px; overflow-y: auto; border: 1px solid #ccc }
+article.item { box-sizing: border-box; height: 150px; margin: 0; padding: 8px; border-bottom: 1px solid #ddd }
+#error { display: none; color: red }
+</style>
+</head>
+<body>
+<div id="error"></div>
+<div id="feed"{{if .Feed.Nested}} class="nested"{{end}}>
+{{- range .Items}}
+<article class="item" id="item-{{.Id}}" data-id="{{.Id}}"><a href="/item/{{.Id}}">{{.Title}}</a><p>{{.Text}}</p></article>
+{{- end}}
+</div>
+{{if eq .Feed.Mode 3}}<button id="more">Show more</button>{{end}}
+<script>
+const cfg = {{.Feed}}
+const feed = document.getElementById("feed")
+const errBox = document.getElementById("error")
+const scroller = cfg.Nested ? feed : document.scrollingElement
+let lo = {{.From}}, hi = {{.To}}, pad = 0, busy = false, done = false
+
+function render(it) {
+	const a = document.createElement("article")
+	a.className = "item"
+	a.id = "item-" + it.Id
+	a.dataset.id = it.Id
+	const link = document.createElement("a")
+	link.href = "/item/" + it.Id
+	link.textContent = it.Title
+	const p = document.createElement("p")
+	p.textContent = it.Text
+	a.append(link, p)
+	return a
+}
+
+function end() {
+	done = true
+	if (cfg.Sentinel) {
+		const e = document.createElement("div")
+		e.id = "end"
+		e.textContent = "End of feed"
+		cfg.Mode == 1 ? feed.prepend(e) : feed.append(e)
+	}
+	const more = document.getElementById("more")
+	if (more) more.remove()
+}
+
+// Remove items above the window, keep scroll position with padding
+function recycle() {
+	const items = feed.querySelectorAll("article.item")
+	for (let i = 0; i < items.length - cfg.Window; i++) {
+		pad += items[i].offsetHeight
+		items[i].remove()
+	}
+	feed.style.paddingTop = pad + "px"
+}
+
+async function load() {
+	if (busy || done) return
+	busy = true
+	const up = cfg.Mode == 1
+	const from = up ? Math.max(0, lo - cfg.PageSize) : hi
+	const to = up ? lo : Math.min(cfg.Items, hi + cfg.PageSize)
+	try {
+		const r = await fetch("/items?from=" + from + "&to=" + to)
+		if (!r.ok) throw new Error("load failed: " + r.status)
+		const items = (await r.json()).map(render)
+		errBox.style.display = "none"
+		if (up) {
+			const h = scroller.scrollHeight
+			feed.prepend(...items)
+			scroller.scrollTop += scroller.scrollHeight - h
+			lo = from
+		} else {
+			feed.append(...items)
+			hi = to
+			if (cfg.Mode == 2) recycle()
+		}
+		if (up ? lo <= 0 : hi >= cfg.Items) end()
+	} catch (e) {
+		errBox.textContent = String(e)
+		errBox.style.display = "block"
+	}
+	busy = false
+}
+
+function check() {
+	const near = 300
+	if (cfg.Mode == 1) {
+		if (scroller.scrollTop <= near) load()
+	} else if (scroller.scrollTop + scroller.clientHeight >= scroller.scrollHeight - near) {
+		load()
+	}
+}
+
+if (cfg.Mode == 1) scroller.scrollTop = scroller.scrollHeight
+if (lo <= 0 && hi >= cfg.Items) {
+	end()
+} else if (cfg.Mode == 3) {
+	document.getElementById("more").addEventListener("click", load)
+} else {
+	(cfg.Nested ? feed : window).addEventListener("scroll", check)
+}
+</script>
+</body>
+</html>
+`))
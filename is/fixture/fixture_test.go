@@ -0,0 +1,145 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package fixture
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// GET [url], return status code and body
+func get(t *testing.T, url string) (int, string) {
+	t.Helper()
+	r, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Body.Close()
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r.StatusCode, string(b)
+}
+
+// GET `/items?from&to`, return item ids
+func getItems(t *testing.T, s *Server, query string) (ids []int) {
+	t.Helper()
+	status, body := get(t, s.URL+"/items?"+query)
+	if status != http.StatusOK {
+		t.Fatalf("items?%s: status %d", query, status)
+	}
+	var items []Item
+	if err := json.Unmarshal([]byte(body), &items); err != nil {
+		t.Fatal(err)
+	}
+	for _, it := range items {
+		if it != NewItem(it.Id) {
+			t.Errorf("item %d = %+v", it.Id, it)
+		}
+		ids = append(ids, it.Id)
+	}
+	return ids
+}
+
+func TestItemsPaging(t *testing.T) {
+	s := New(&Feed{Items: 25})
+	defer s.Close()
+	tests := []struct {
+		query string
+		from  int
+		n     int
+	}{
+		{"from=0&to=10", 0, 10},
+		{"from=10&to=20", 10, 10},
+		{"from=20&to=30", 20, 5}, // clipped at Items
+		{"from=-5&to=3", 0, 3},   // clipped at 0
+		{"from=30&to=40", 0, 0},  // past the end
+	}
+	for _, tt := range tests {
+		ids := getItems(t, s, tt.query)
+		if len(ids) != tt.n {
+			t.Errorf("%s: %d items, want %d", tt.query, len(ids), tt.n)
+			continue
+		}
+		for i, id := range ids {
+			if id != tt.from+i {
+				t.Errorf("%s: item %d id = %d, want %d", tt.query, i, id, tt.from+i)
+			}
+		}
+	}
+	if status, _ := get(t, s.URL+"/items?from=x&to=10"); status != http.StatusBadRequest {
+		t.Errorf("invalid range: status %d, want %d", status, http.StatusBadRequest)
+	}
+	if got := s.Loads(); got != len(tests)+1 {
+		t.Errorf("Loads() = %d, want %d", got, len(tests)+1)
+	}
+}
+
+func TestItemsFailEvery(t *testing.T) {
+	s := New(&Feed{Items: 25, FailEvery: 2})
+	defer s.Close()
+	for i := 1; i <= 4; i++ {
+		status, _ := get(t, s.URL+"/items?from=0&to=10")
+		want := http.StatusOK
+		if i%2 == 0 {
+			want = http.StatusInternalServerError
+		}
+		if status != want {
+			t.Errorf("load %d: status %d, want %d", i, status, want)
+		}
+	}
+	if s.Loads() != 4 || s.Fails() != 2 {
+		t.Errorf("Loads() = %d, Fails() = %d, want 4, 2", s.Loads(), s.Fails())
+	}
+}
+
+func TestPage(t *testing.T) {
+	tests := []struct {
+		feed    Feed
+		has     []string
+		missing string
+	}{
+		{Feed{Mode: ModeAppend, Items: 25}, []string{`id="item-0"`, `id="item-9"`}, `id="item-10"`},
+		{Feed{Mode: ModePrepend, Items: 25}, []string{`id="item-15"`, `id="item-24"`}, `id="item-14"`},
+		{Feed{Mode: ModeLoadMore, Items: 25}, []string{`id="item-0"`, `id="item-9"`, `id="more"`}, `id="item-10"`},
+	}
+	for _, tt := range tests {
+		s := New(&tt.feed)
+		status, body := get(t, s.URL)
+		s.Close()
+		if status != http.StatusOK {
+			t.Errorf("mode %d: status %d", tt.feed.Mode, status)
+		}
+		for _, want := range tt.has {
+			if !strings.Contains(body, want) {
+				t.Errorf("mode %d: page has no %s", tt.feed.Mode, want)
+			}
+		}
+		if strings.Contains(body, tt.missing) {
+			t.Errorf("mode %d: page has %s", tt.feed.Mode, tt.missing)
+		}
+	}
+}
@@ -47,27 +47,31 @@ type IInfo interface {
 
 // IInfo base struct to be embedded
 //   - Only String() should be overloaded
+//   - Match state is marshalled as `Matched` and `MatchedStr` of the info struct
 type InfoBase struct {
-	matched    bool
-	matchedStr string
+	InfoMatched    bool               `json:"Matched"`              // Use [Matched] and [SetMatched]
+	InfoMatchedStr string             `json:"MatchedStr,omitempty"` // Use [MatchedStr] and [SetMatchedStr]
+	InfoLabels     map[string]float64 `json:"Labels,omitempty"`     // Use [IInfoLabels] functions
 }
 
 // Get matched bool value
-func (t *InfoBase) Matched() bool { return t.matched }
+func (t *InfoBase) Matched() bool { return t.InfoMatched }
 
 // Get matched string value
-func (t *InfoBase) MatchedStr() string { return t.matchedStr }
+func (t *InfoBase) MatchedStr() string { return t.InfoMatchedStr }
 
 // Set matched bool value
-func (t *InfoBase) SetMatched(matched bool) { t.matched = matched }
+func (t *InfoBase) SetMatched(matched bool) { t.InfoMatched = matched }
 
 // Set matched string value
-func (t *InfoBase) SetMatchedStr(matchedStr string) { t.matchedStr = matchedStr }
+func (t *InfoBase) SetMatchedStr(matchedStr string) { t.InfoMatchedStr = matchedStr }
 
 // Place holder only
 func (t *InfoBase) String() string { return "String() placeholder!" }
 
 // Implemented Print() and sort.Interface
+//
+// Marshalled with type discriminator, see [RegisterInfo].
 type IInfoList []IInfo
 
 func (t *IInfoList) Print(mode IInfoListPrintMode) {
@@ -0,0 +1,116 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package is
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/J-Siu/go-helper/v2/ezlog"
+)
+
+// Optional interface for info struct with labels. Implemented by [InfoBase].
+//
+// Labels allow a single run to route an item into several buckets. See [Property.LabelProcess].
+type IInfoLabels interface {
+	Labels() []string                            // Return sorted labels
+	Label(label string) (score float64, ok bool) // Return score of [label]
+	SetLabel(label string, score float64)        // Add or update [label]
+	RemoveLabel(label string)                    // Remove [label]
+}
+
+// Return sorted labels
+func (t *InfoBase) Labels() []string { return slices.Sorted(maps.Keys(t.InfoLabels)) }
+
+// Return score of [label]
+func (t *InfoBase) Label(label string) (score float64, ok bool) {
+	score, ok = t.InfoLabels[label]
+	return score, ok
+}
+
+// Add or update [label] with [score]
+func (t *InfoBase) SetLabel(label string, score float64) {
+	if t.InfoLabels == nil {
+		t.InfoLabels = make(map[string]float64)
+	}
+	t.InfoLabels[label] = score
+}
+
+// Remove [label]
+func (t *InfoBase) RemoveLabel(label string) { delete(t.InfoLabels, label) }
+
+// Return true if [info] has [label]
+func HasLabel(info IInfo, label string) bool {
+	if l, ok := info.(IInfoLabels); ok {
+		_, ok = l.Label(label)
+		return ok
+	}
+	return false
+}
+
+// Return items with [label]
+func (t *IInfoList) WithLabel(label string) (list IInfoList) {
+	for _, info := range *t {
+		if HasLabel(info, label) {
+			list = append(list, info)
+		}
+	}
+	return list
+}
+
+// Print items with [label], with their labels and scores
+func (t *IInfoList) PrintLabel(label string) {
+	for c, info := range *t {
+		if !HasLabel(info, label) {
+			continue
+		}
+		var str string
+		if l, ok := info.(IInfoLabels); ok {
+			for _, name := range l.Labels() {
+				score, _ := l.Label(name)
+				str += name + "(" + strconv.FormatFloat(score, 'g', -1, 64) + ")"
+			}
+		}
+		ezlog.Log().Indent(false).M(c + 1).M("|").M(str).M("|").M(info.String()).Out()
+	}
+}
+
+// Run [LabelProcess] hook of each label of [StateCurr.ElementInfo], in label order
+//
+// Return false if a hook failed.
+func (t *Processor) processLabels() bool {
+	l, ok := t.StateCurr.ElementInfo.(IInfoLabels)
+	if !ok {
+		return true
+	}
+	for _, label := range l.Labels() {
+		t.Report.Labels[label]++
+		if f := t.LabelProcess[label]; f != nil {
+			if !t.funcWrapper("Label:"+label, f) {
+				return false
+			}
+		}
+	}
+	return true
+}
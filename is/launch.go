@@ -0,0 +1,213 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package is
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sync"
+
+	"github.com/runZeroInc/go-rod"
+	"github.com/runZeroInc/go-rod/lib/launcher"
+	"github.com/runZeroInc/go-rod/lib/launcher/flags"
+	"github.com/runZeroInc/go-rod/lib/proto"
+)
+
+// Property of [Browser]
+type BrowserProperty struct {
+	// Devtools endpoint of a running browser. eg. "9222", "localhost:9222", "ws://...".
+	// A local browser is launched if empty.
+	ControlURL string `json:"ControlURL,omitempty"`
+
+	// -- Launched browser only
+
+	Bin         string            `json:"Bin,omitempty"`         // Browser binary. (default: system Chromium, or download one)
+	UserDataDir string            `json:"UserDataDir,omitempty"` // User data dir (profile), kept on [Browser.Close]. (default: a temp dir, removed on close)
+	Headful     bool              `json:"Headful,omitempty"`     // Show browser window. (default: headless)
+	NoSandbox   bool              `json:"NoSandbox,omitempty"`   // Launch with `--no-sandbox`. eg. running as root in a container
+	Flags       map[string]string `json:"Flags,omitempty"`       // Extra command line flags, without leading `--`. Empty value for a switch
+}
+
+// Browser launched locally, or attached to a running browser, providing pages for [Property.Page]
+//
+// [Close] closes only what it started: a launched browser, or tabs opened in an attached browser.
+type Browser struct {
+	BrowserProperty
+
+	Rod *rod.Browser
+
+	launcher *launcher.Launcher // nil if attached
+	profile  string             // Temp user data dir created by [launcher]
+	opened   []*rod.Page        // Tabs opened by [NewPage]
+	mu       sync.Mutex         // Guard [opened]
+	cancel   context.CancelFunc
+}
+
+// Launch a local browser, or attach to [BrowserProperty.ControlURL]. Call [Browser.Close] when done.
+//
+// [property] can be nil, which launches a headless browser with a temp user data dir.
+func NewBrowser(ctx context.Context, property *BrowserProperty) (t *Browser, err error) {
+	prefix := "is.NewBrowser"
+	t = new(Browser)
+	if property != nil {
+		t.BrowserProperty = *property
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, t.cancel = context.WithCancel(ctx)
+	var u string
+	if t.ControlURL != "" {
+		u, err = launcher.ResolveURL(t.ControlURL)
+	} else {
+		u, err = t.launch(ctx)
+	}
+	if err == nil {
+		t.Rod = rod.New().Context(ctx).ControlURL(u)
+		if t.launcher == nil {
+			// Keep window size of user's browser
+			t.Rod = t.Rod.NoDefaultDevice()
+		}
+		err = t.Rod.Connect()
+	}
+	if err != nil {
+		t.Close()
+		return nil, fmt.Errorf("%s: %w", prefix, err)
+	}
+	return t, nil
+}
+
+// Start local browser, return its devtools URL
+func (t *Browser) launch(ctx context.Context) (u string, err error) {
+	opts := []launcher.BrowserOption{launcher.WithContext(ctx)}
+	if t.Bin != "" {
+		opts = append(opts, launcher.WithUseChromiumPath(t.Bin))
+	}
+	if t.launcher, err = launcher.New(opts...); err != nil {
+		return "", err
+	}
+	t.profile = t.launcher.Get(flags.UserDataDir)
+	t.launcher.Headless(!t.Headful).NoSandbox(t.NoSandbox)
+	if t.UserDataDir != "" {
+		t.launcher.UserDataDir(t.UserDataDir)
+	}
+	for k, v := range t.Flags {
+		if v == "" {
+			t.launcher.Set(flags.Flag(k))
+		} else {
+			t.launcher.Set(flags.Flag(k), v)
+		}
+	}
+	return t.launcher.Launch()
+}
+
+// Return first tab with URL matching regexp [pattern], or a new tab loading [url] if none matches
+//
+// Empty [pattern] matches any tab. Empty [url] opens a blank tab.
+func (t *Browser) Page(pattern, url string) (page *RodPage, err error) {
+	prefix := "Browser.Page"
+	var (
+		re    *regexp.Regexp
+		pages rod.Pages
+		info  *proto.TargetTargetInfo
+	)
+	if pattern != "" {
+		re, err = regexp.Compile(pattern)
+	}
+	if err == nil {
+		pages, err = t.Rod.Pages()
+	}
+	for _, p := range pages {
+		if err != nil {
+			break
+		}
+		if info, err = p.Info(); err == nil && (re == nil || re.MatchString(info.URL)) {
+			if _, err = p.Activate(); err == nil {
+				return &RodPage{Rod: p}, nil
+			}
+		}
+	}
+	if err == nil {
+		return t.NewPage(url)
+	}
+	return nil, fmt.Errorf("%s: %w", prefix, err)
+}
+
+// Open a new tab loading [url]. Empty [url] opens a blank tab. The tab is closed by [Close].
+func (t *Browser) NewPage(url string) (page *RodPage, err error) {
+	var p *rod.Page
+	if p, err = t.Rod.Page(proto.TargetCreateTarget{URL: url}); err != nil {
+		return nil, fmt.Errorf("Browser.NewPage: %w", err)
+	}
+	t.mu.Lock()
+	t.opened = append(t.opened, p)
+	t.mu.Unlock()
+	return &RodPage{Rod: p}, nil
+}
+
+// Close tab [page] opened by [NewPage]
+func (t *Browser) ClosePage(page *RodPage) (err error) {
+	t.mu.Lock()
+	t.opened = slices.DeleteFunc(t.opened, func(p *rod.Page) bool { return p == page.Rod })
+	t.mu.Unlock()
+	if err = page.Rod.Close(); err != nil {
+		err = fmt.Errorf("Browser.ClosePage: %w", err)
+	}
+	return err
+}
+
+// Close what [Browser] started
+//
+//   - Launched browser: close it, and remove its temp user data dir. [UserDataDir] is kept.
+//   - Attached browser: close tabs opened by [NewPage], and leave the browser running.
+func (t *Browser) Close() (err error) {
+	if t.launcher != nil {
+		if t.Rod != nil {
+			if err = t.Rod.Close(); err != nil {
+				t.launcher.Kill()
+			}
+		}
+		if t.UserDataDir != "" {
+			// [launcher.Cleanup] removes user data dir flag. Point it back to the unused temp dir.
+			t.launcher.Set(flags.UserDataDir, t.profile)
+		}
+		t.launcher.Cleanup()
+	} else {
+		t.mu.Lock()
+		for _, p := range t.opened {
+			err = errors.Join(err, p.Close())
+		}
+		t.mu.Unlock()
+	}
+	t.opened = nil
+	if t.cancel != nil {
+		t.cancel()
+	}
+	if err != nil {
+		err = fmt.Errorf("Browser.Close: %w", err)
+	}
+	return err
+}
@@ -0,0 +1,375 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+// Package [match] is a declarative rule-based matcher for [is.Processor.V040_ElementMatch].
+//
+// Rules test fields of the info struct, and can be loaded from JSON or YAML:
+//
+//	rules:
+//	  - name: product
+//	    field: Text
+//	    op: contains
+//	    value: go-rod
+//	    ignoreCase: true
+//	  - name: vip
+//	    any:
+//	      - { field: User, op: equals, value: alice }
+//	      - { field: User, op: regex, value: "^bob" }
+//
+// An info is matched if any top-level rule fires. [is.IInfo.MatchedStr] is set to the
+// names of the fired rules, separated by [Matcher.Sep]. With [Matcher.Labels], the names are also added as labels.
+package match
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/J-Siu/go-is/v3/is"
+	"gopkg.in/yaml.v3"
+)
+
+// Rule operators
+const (
+	OpContains = "contains" // field contains value
+	OpEquals   = "equals"   // field equals value
+	OpRegex    = "regex"    // field matches regular expression value
+	OpEq       = "eq"       // numeric ==
+	OpNe       = "ne"       // numeric !=
+	OpGt       = "gt"       // numeric >
+	OpGe       = "ge"       // numeric >=
+	OpLt       = "lt"       // numeric <
+	OpLe       = "le"       // numeric <=
+)
+
+// A rule is either a field test ([Field], [Op], [Value]), or a group ([Any], [All], [Not])
+type Rule struct {
+	Name  string  `json:"name,omitempty" yaml:"name,omitempty"`   // Reported in MatchedStr, and used as label, if a top-level rule fires
+	Score float64 `json:"score,omitempty" yaml:"score,omitempty"` // Label score if [Matcher.Labels]. (default: 1)
+
+	// -- Field test
+
+	Field      string `json:"field,omitempty" yaml:"field,omitempty"`           // Field name or json tag. Dot for nested field. Empty for [is.IInfo.String]
+	Op         string `json:"op,omitempty" yaml:"op,omitempty"`                 // One of the Op* constants
+	Value      string `json:"value,omitempty" yaml:"value,omitempty"`           // Value to compare. Parsed as number by numeric operators
+	IgnoreCase bool   `json:"ignoreCase,omitempty" yaml:"ignoreCase,omitempty"` // Case insensitive [OpContains], [OpEquals], [OpRegex]
+
+	// -- Group
+
+	Any []*Rule `json:"any,omitempty" yaml:"any,omitempty"` // Fires if any sub-rule fires
+	All []*Rule `json:"all,omitempty" yaml:"all,omitempty"` // Fires if all sub-rules fire
+	Not *Rule   `json:"not,omitempty" yaml:"not,omitempty"` // Fires if sub-rule does not fire
+
+	re  *regexp.Regexp
+	num float64
+}
+
+// Rule based matcher
+//
+// Safe for concurrent use. Do not copy after first use.
+type Matcher struct {
+	Rules  []*Rule `json:"rules" yaml:"rules"`
+	Sep    string  `json:"sep,omitempty" yaml:"sep,omitempty"`       // Separator of fired rule names in MatchedStr. (default: ",")
+	Labels bool    `json:"labels,omitempty" yaml:"labels,omitempty"` // Also add fired rule names as labels, if info implements [is.IInfoLabels]
+
+	compiled bool
+	once     sync.Once // Guard lazy [Compile] in [Match]
+	err      error     // Result of lazy [Compile]
+}
+
+// Parse JSON rules and compile them
+func LoadJSON(b []byte) (m *Matcher, err error) {
+	m = new(Matcher)
+	if err = json.Unmarshal(b, m); err == nil {
+		err = m.Compile()
+	}
+	if err != nil {
+		return nil, errors.New("match.LoadJSON: " + err.Error())
+	}
+	return m, nil
+}
+
+// Parse YAML rules and compile them
+func LoadYAML(b []byte) (m *Matcher, err error) {
+	m = new(Matcher)
+	if err = yaml.Unmarshal(b, m); err == nil {
+		err = m.Compile()
+	}
+	if err != nil {
+		return nil, errors.New("match.LoadYAML: " + err.Error())
+	}
+	return m, nil
+}
+
+// Load rules from [file]. `.yaml` and `.yml` are parsed as YAML, others as JSON.
+func LoadFile(file string) (m *Matcher, err error) {
+	var b []byte
+	if b, err = os.ReadFile(file); err != nil {
+		return nil, errors.New("match.LoadFile: " + err.Error())
+	}
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".yaml", ".yml":
+		return LoadYAML(b)
+	}
+	return LoadJSON(b)
+}
+
+// Validate rules and compile regular expressions. Called by Load* functions.
+func (m *Matcher) Compile() (err error) {
+	for i, r := range m.Rules {
+		if err = r.compile(); err != nil {
+			return fmt.Errorf("rule %d (%s): %w", i, r.Name, err)
+		}
+	}
+	m.compiled = true
+	return nil
+}
+
+func (r *Rule) compile() (err error) {
+	if r == nil {
+		return errors.New("nil rule")
+	}
+	groups := 0
+	for _, g := range []bool{len(r.Any) > 0, len(r.All) > 0, r.Not != nil, r.Op != ""} {
+		if g {
+			groups++
+		}
+	}
+	if groups != 1 {
+		return errors.New("rule must have exactly one of op, any, all, not")
+	}
+	for _, sub := range append(append([]*Rule{}, r.Any...), r.All...) {
+		if err = sub.compile(); err != nil {
+			return err
+		}
+	}
+	if r.Not != nil {
+		return r.Not.compile()
+	}
+	switch r.Op {
+	case "", OpContains, OpEquals:
+	case OpRegex:
+		expr := r.Value
+		if r.IgnoreCase {
+			expr = "(?i)" + expr
+		}
+		r.re, err = regexp.Compile(expr)
+	case OpEq, OpNe, OpGt, OpGe, OpLt, OpLe:
+		r.num, err = strconv.ParseFloat(r.Value, 64)
+	default:
+		err = errors.New("unknown op: " + r.Op)
+	}
+	return err
+}
+
+// Test [info] against all top-level rules. Return fired rule names.
+//
+// Unnamed rules are reported as `rule<index>`.
+// Rules not compiled by a Load* function are compiled on first call, once. A compile error is returned on every call.
+func (m *Matcher) Match(info is.IInfo) (matched bool, names []string, err error) {
+	m.once.Do(func() {
+		if !m.compiled {
+			m.err = m.Compile()
+		}
+	})
+	if m.err != nil {
+		return false, nil, fmt.Errorf("match.Match: %w", m.err)
+	}
+	for i, r := range m.Rules {
+		if r.match(info) {
+			name := r.Name
+			if name == "" {
+				name = "rule" + strconv.Itoa(i)
+			}
+			names = append(names, name)
+		}
+	}
+	return len(names) > 0, names, nil
+}
+
+// Set [is.IInfo.SetMatched] and [is.IInfo.SetMatchedStr] of [info] with [Match] result
+//
+// [info] is not changed if [Match] returns an error.
+func (m *Matcher) Apply(info is.IInfo) error {
+	sep := m.Sep
+	if sep == "" {
+		sep = ","
+	}
+	matched, names, err := m.Match(info)
+	if err != nil {
+		return err
+	}
+	info.SetMatched(matched)
+	info.SetMatchedStr(strings.Join(names, sep))
+	if l, ok := info.(is.IInfoLabels); ok && m.Labels {
+		for i, r := range m.Rules {
+			name := r.Name
+			if name == "" {
+				name = "rule" + strconv.Itoa(i)
+			}
+			if slices.Contains(names, name) {
+				score := r.Score
+				if score == 0 {
+					score = 1
+				}
+				l.SetLabel(name, score)
+			}
+		}
+	}
+	return nil
+}
+
+// Return a field function for [is.Processor.V040_ElementMatch], applying the rules to [StateCurr.ElementInfo]
+//
+//	p.V040_ElementMatch = matcher.V040(p.Processor)
+func (m *Matcher) V040(p *is.Processor) is.ProcessorFunc {
+	return func() {
+		prefix := p.MyType + ".V040_ElementMatch" + "(match)"
+		p.StateCurr.Name = prefix
+		if p.StateCurr.ElementInfo != nil {
+			if err := m.Apply(p.StateCurr.ElementInfo); err != nil {
+				p.Err = fmt.Errorf("%s: %w", prefix, err)
+				return
+			}
+			if p.Logger != nil {
+				p.Logger.Trace().N(prefix).N(p.StateCurr.ElementInfo.MatchedStr()).M(p.StateCurr.ElementInfo.Matched()).Out()
+			}
+		}
+	}
+}
+
+func (r *Rule) match(info is.IInfo) bool {
+	switch {
+	case len(r.Any) > 0:
+		for _, sub := range r.Any {
+			if sub.match(info) {
+				return true
+			}
+		}
+		return false
+	case len(r.All) > 0:
+		for _, sub := range r.All {
+			if !sub.match(info) {
+				return false
+			}
+		}
+		return true
+	case r.Not != nil:
+		return !r.Not.match(info)
+	}
+	str, ok := field(info, r.Field)
+	if !ok {
+		return false
+	}
+	switch r.Op {
+	case OpContains:
+		if r.IgnoreCase {
+			return strings.Contains(strings.ToLower(str), strings.ToLower(r.Value))
+		}
+		return strings.Contains(str, r.Value)
+	case OpEquals:
+		if r.IgnoreCase {
+			return strings.EqualFold(str, r.Value)
+		}
+		return str == r.Value
+	case OpRegex:
+		return r.re.MatchString(str)
+	}
+	num, err := strconv.ParseFloat(strings.TrimSpace(str), 64)
+	if err != nil {
+		return false
+	}
+	switch r.Op {
+	case OpEq:
+		return num == r.num
+	case OpNe:
+		return num != r.num
+	case OpGt:
+		return num > r.num
+	case OpGe:
+		return num >= r.num
+	case OpLt:
+		return num < r.num
+	case OpLe:
+		return num <= r.num
+	}
+	return false
+}
+
+// Return string value of field [path] of [info]. Empty [path] returns [is.IInfo.String].
+func field(info is.IInfo, path string) (string, bool) {
+	if path == "" {
+		return info.String(), true
+	}
+	v := reflect.ValueOf(info)
+	for name := range strings.SplitSeq(path, ".") {
+		for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return "", false
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return "", false
+		}
+		sf, ok := fieldByName(v.Type(), name)
+		if !ok {
+			return "", false
+		}
+		var err error
+		if v, err = v.FieldByIndexErr(sf.Index); err != nil {
+			return "", false
+		}
+	}
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.String {
+		return v.String(), true
+	}
+	return fmt.Sprint(v.Interface()), true
+}
+
+// Find exported field by name, or by json tag
+func fieldByName(typ reflect.Type, name string) (reflect.StructField, bool) {
+	for _, sf := range reflect.VisibleFields(typ) {
+		if !sf.IsExported() || sf.Anonymous {
+			continue
+		}
+		tag, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if sf.Name == name || tag == name {
+			return sf, true
+		}
+	}
+	return reflect.StructField{}, false
+}
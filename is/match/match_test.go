@@ -0,0 +1,250 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package match_test
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/J-Siu/go-is/v3/is/match"
+	"gopkg.in/yaml.v3"
+)
+
+type post struct {
+	is.InfoBase
+	User   string `json:"user"`
+	Text   string
+	Likes  int
+	Author *author
+}
+
+type author struct {
+	Name string
+}
+
+func (p *post) String() string { return p.User + ": " + p.Text }
+
+func TestCompileError(t *testing.T) {
+	tests := []struct {
+		name string
+		rule *match.Rule
+		want string
+	}{
+		{"bad regex", &match.Rule{Field: "Text", Op: match.OpRegex, Value: "("}, "missing closing )"},
+		{"unknown op", &match.Rule{Field: "Text", Op: "like", Value: "x"}, "unknown op: like"},
+		{"bad number", &match.Rule{Field: "Likes", Op: match.OpGt, Value: "many"}, "invalid syntax"},
+		{"no op", &match.Rule{Field: "Text"}, "exactly one of"},
+		{"op and group", &match.Rule{Op: match.OpEquals, Not: &match.Rule{Op: match.OpEquals}}, "exactly one of"},
+		{"nested", &match.Rule{Any: []*match.Rule{{Op: "like"}}}, "unknown op: like"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &match.Matcher{Rules: []*match.Rule{tt.rule}}
+			err := m.Compile()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Compile() = %v, want %q", err, tt.want)
+			}
+			// lazy compile returns the same error on every call
+			m = &match.Matcher{Rules: []*match.Rule{tt.rule}}
+			for range 2 {
+				if _, _, err = m.Match(&post{}); err == nil || !strings.Contains(err.Error(), tt.want) {
+					t.Fatalf("Match() = %v, want %q", err, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	info := &post{User: "alice", Text: "Hello Go-Rod", Likes: 42, Author: &author{Name: "Al"}}
+	tests := []struct {
+		name string
+		rule match.Rule
+		want bool
+	}{
+		{"contains", match.Rule{Field: "Text", Op: match.OpContains, Value: "Go-Rod"}, true},
+		{"contains case", match.Rule{Field: "Text", Op: match.OpContains, Value: "go-rod"}, false},
+		{"contains ignore case", match.Rule{Field: "Text", Op: match.OpContains, Value: "go-rod", IgnoreCase: true}, true},
+		{"equals json tag", match.Rule{Field: "user", Op: match.OpEquals, Value: "alice"}, true},
+		{"equals ignore case", match.Rule{Field: "User", Op: match.OpEquals, Value: "ALICE", IgnoreCase: true}, true},
+		{"regex", match.Rule{Field: "Text", Op: match.OpRegex, Value: "^hello", IgnoreCase: true}, true},
+		{"string", match.Rule{Op: match.OpContains, Value: "alice: Hello"}, true},
+		{"eq", match.Rule{Field: "Likes", Op: match.OpEq, Value: "42"}, true},
+		{"ne", match.Rule{Field: "Likes", Op: match.OpNe, Value: "42"}, false},
+		{"gt", match.Rule{Field: "Likes", Op: match.OpGt, Value: "41.5"}, true},
+		{"ge", match.Rule{Field: "Likes", Op: match.OpGe, Value: "42"}, true},
+		{"lt", match.Rule{Field: "Likes", Op: match.OpLt, Value: "42"}, false},
+		{"le", match.Rule{Field: "Likes", Op: match.OpLe, Value: "42"}, true},
+		{"numeric on text", match.Rule{Field: "Text", Op: match.OpGt, Value: "0"}, false},
+		{"nested field", match.Rule{Field: "Author.Name", Op: match.OpEquals, Value: "Al"}, true},
+		{"unknown field", match.Rule{Field: "Nope", Op: match.OpContains, Value: ""}, false},
+		{"any", match.Rule{Any: []*match.Rule{
+			{Field: "User", Op: match.OpEquals, Value: "bob"},
+			{Field: "Likes", Op: match.OpGt, Value: "10"},
+		}}, true},
+		{"all", match.Rule{All: []*match.Rule{
+			{Field: "User", Op: match.OpEquals, Value: "bob"},
+			{Field: "Likes", Op: match.OpGt, Value: "10"},
+		}}, false},
+		{"not", match.Rule{Not: &match.Rule{Field: "User", Op: match.OpEquals, Value: "bob"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &match.Matcher{Rules: []*match.Rule{&tt.rule}}
+			got, _, err := m.Match(info)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// nil nested pointer does not match
+	m := &match.Matcher{Rules: []*match.Rule{{Field: "Author.Name", Op: match.OpContains, Value: ""}}}
+	if got, _, _ := m.Match(&post{}); got {
+		t.Error("Match() of nil Author = true, want false")
+	}
+}
+
+func TestApply(t *testing.T) {
+	m := &match.Matcher{
+		Sep:    "|",
+		Labels: true,
+		Rules: []*match.Rule{
+			{Name: "vip", Score: 0.5, Field: "User", Op: match.OpEquals, Value: "alice"},
+			{Field: "Likes", Op: match.OpGe, Value: "10"},
+			{Name: "spam", Field: "Text", Op: match.OpContains, Value: "buy now"},
+		},
+	}
+	info := &post{User: "alice", Likes: 10}
+	if err := m.Apply(info); err != nil {
+		t.Fatal(err)
+	}
+	if !info.Matched() || info.MatchedStr() != "vip|rule1" {
+		t.Errorf("Matched = %v, MatchedStr = %q, want true, \"vip|rule1\"", info.Matched(), info.MatchedStr())
+	}
+	if got, want := info.Labels(), []string{"rule1", "vip"}; !slices.Equal(got, want) {
+		t.Errorf("Labels = %v, want %v", got, want)
+	}
+	if score, _ := info.Label("vip"); score != 0.5 {
+		t.Errorf("vip score = %v, want 0.5", score)
+	}
+	if score, _ := info.Label("rule1"); score != 1 {
+		t.Errorf("rule1 score = %v, want 1 (default)", score)
+	}
+
+	info = &post{User: "bob"}
+	info.SetMatched(true)
+	if err := m.Apply(info); err != nil {
+		t.Fatal(err)
+	}
+	if info.Matched() || info.MatchedStr() != "" || len(info.Labels()) != 0 {
+		t.Errorf("unmatched: Matched = %v, MatchedStr = %q, Labels = %v", info.Matched(), info.MatchedStr(), info.Labels())
+	}
+
+	// compile error leaves info unchanged
+	bad := &match.Matcher{Rules: []*match.Rule{{Field: "Text", Op: match.OpRegex, Value: "("}}}
+	info.SetMatchedStr("keep")
+	if err := bad.Apply(info); err == nil || info.MatchedStr() != "keep" {
+		t.Errorf("Apply() = %v, MatchedStr = %q, want error and \"keep\"", err, info.MatchedStr())
+	}
+}
+
+// Lazy compile under concurrent Match. Run with -race.
+func TestMatchConcurrent(t *testing.T) {
+	m := &match.Matcher{Rules: []*match.Rule{
+		{Name: "re", Field: "Text", Op: match.OpRegex, Value: "go", IgnoreCase: true},
+		{Name: "n", Field: "Likes", Op: match.OpGt, Value: "1"},
+	}}
+	var wg sync.WaitGroup
+	for range 16 {
+		wg.Go(func() {
+			matched, names, err := m.Match(&post{Text: "GO", Likes: 2})
+			if err != nil || !matched || len(names) != 2 {
+				t.Errorf("Match() = %v, %v, %v", matched, names, err)
+			}
+		})
+	}
+	wg.Wait()
+}
+
+func TestLoad(t *testing.T) {
+	src := &match.Matcher{
+		Sep:    ";",
+		Labels: true,
+		Rules: []*match.Rule{
+			{Name: "product", Field: "Text", Op: match.OpContains, Value: "go-rod", IgnoreCase: true},
+			{Name: "vip", Score: 2, Any: []*match.Rule{
+				{Field: "user", Op: match.OpEquals, Value: "alice"},
+				{Field: "user", Op: match.OpRegex, Value: "^bob"},
+			}},
+			{Name: "quiet", Not: &match.Rule{Field: "Likes", Op: match.OpGt, Value: "100"}},
+		},
+	}
+	jsonB, err := json.Marshal(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	yamlB, err := yaml.Marshal(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaders := []struct {
+		name string
+		load func() (*match.Matcher, error)
+	}{
+		{"json", func() (*match.Matcher, error) { return match.LoadJSON(jsonB) }},
+		{"yaml", func() (*match.Matcher, error) { return match.LoadYAML(yamlB) }},
+	}
+	info := &post{User: "bobby", Text: "About GO-ROD", Likes: 5}
+	for _, l := range loaders {
+		t.Run(l.name, func(t *testing.T) {
+			m, err := l.load()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if m.Sep != ";" || !m.Labels || len(m.Rules) != 3 || m.Rules[1].Score != 2 || len(m.Rules[1].Any) != 2 || m.Rules[2].Not == nil {
+				t.Fatalf("loaded = %+v", m)
+			}
+			_, names, err := m.Match(info)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := []string{"product", "vip", "quiet"}; !slices.Equal(names, want) {
+				t.Errorf("names = %v, want %v", names, want)
+			}
+		})
+	}
+
+	if _, err = match.LoadJSON([]byte(`{"rules": [{"field": "Text", "op": "regex", "value": "("}]}`)); err == nil {
+		t.Error("LoadJSON() of bad regex = nil error")
+	}
+	if _, err = match.LoadYAML([]byte("rules:\n  - op: like\n")); err == nil {
+		t.Error("LoadYAML() of unknown op = nil error")
+	}
+}
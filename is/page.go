@@ -0,0 +1,68 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package is
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/runZeroInc/go-rod/pkg/gson"
+)
+
+// Page used by [Processor]
+//
+// [RodPage] wraps a [rod.Page]. Package `github.com/J-Siu/go-is/v3/is/fake` provides an in-memory page
+// with scripted DOM snapshots, for testing processors without a browser.
+type IPage interface {
+	Context(ctx context.Context) IPage            // Return a copy of the page bound to [ctx]
+	GetContext() context.Context                  // Return context of the page
+	Navigate(url string) error                    // Load [url]
+	Element(selector string) (IElement, error)    // Return first element matching css [selector], wait until it exists
+	Elements(selector string) ([]IElement, error) // Return all elements matching css [selector]
+	Has(selector string) (bool, IElement, error)  // Return first element matching css [selector] if exists, without waiting
+
+	// Return DOM fingerprint of [elements]. Empty key if an element is detached. See [Processor.V025_ElementKeys]
+	Keys(elements []IElement) ([]string, error)
+	// Run JS function [fn] `(el) => object` on each of [elements], return results as JSON. See [Property.ExtractJS]
+	ExtractJS(fn string, elements []IElement) ([]json.RawMessage, error)
+	// Scroll [container], or document if nil, with [mode]. Return scroll height. [ScrollModeElement] only returns scroll height.
+	Scroll(container IElement, mode ScrollMode, step float64, pixels int, dir ScrollDirection) (height int, err error)
+
+	WaitDOMStable(d time.Duration, diff float64) error // Wait until DOM change is less or equal than [diff] for [d]
+	WaitIdle(d time.Duration) error                    // Wait until no network request for [d]
+}
+
+// Element used by [Processor]. See [IPage]
+type IElement interface {
+	Context(ctx context.Context) IElement         // Return a copy of the element bound to [ctx]
+	Element(selector string) (IElement, error)    // Return first child matching css [selector]
+	Elements(selector string) ([]IElement, error) // Return all children matching css [selector]
+	Text() (string, error)                        // Return visible text
+	HTML() (string, error)                        // Return outer HTML
+	Attribute(name string) (*string, error)       // Return attribute [name], `nil` if not exist
+	Property(name string) (gson.JSON, error)      // Return DOM property [name]
+	ScrollIntoView() error                        // Scroll element into view
+	WaitEnabled() error                           // Wait until element is not disabled
+	Click() error                                 // Click element once it is interactable and enabled
+}
@@ -0,0 +1,281 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package is
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/J-Siu/go-helper/v2/ezlog"
+)
+
+// Default [Pool.Concurrency]
+const PoolConcurrencyDefault = 4
+
+// Return a processor for [property], with field functions overridden. eg.
+//
+//	func(p *is.Property) *is.Processor { return new(xfp.XFeedProcessor).New(p).Processor }
+//
+// [property] is a shallow copy of [Pool.Property] for one URL, with [Page], [UrlStr], [UrlLoad] and [IInfoList] set,
+// [Container] cleared, and [Sinks] wrapped with [SyncSink]. Other reference fields are shared by all URLs.
+// [Pool.Run] fails if [Pool.Property] has stateful fields [StopCond], [LabelProcess], [CheckpointFile], [WatermarkFile]
+// or [RecordFile]. Set them per URL here, or use the serializable [Property.Stop], which is built per processor.
+// Also set here field functions using a matcher or sink not safe for concurrent use.
+// Give each processor its own [Processor.Logger], not [Pool.Logger], as [ezlog.EzLog] is not safe for concurrent use.
+type ProcessorFactory func(property *Property) *Processor
+
+// Run processors on multiple URLs concurrently, one tab per URL
+type Pool struct {
+	Browser     *Browser         // Tabs are opened in it, and closed after processing
+	Property    Property         // Template of [Property] of each processor
+	Factory     ProcessorFactory // REQUIRED
+	Concurrency int              // Maximum number of tabs processing at the same time. (default: [PoolConcurrencyDefault])
+	PerDomain   int              // Maximum number of tabs processing the same host at the same time. 0 = no limit
+
+	// Open a tab for [url]. (default: [Browser.NewPage], closed with [Browser.ClosePage])
+	NewPage func(url string) (page IPage, closePage func() error, err error) `json:"-"`
+
+	Logger *ezlog.EzLog // Used by [Run] only. Calls are serialized.
+
+	logMu sync.Mutex // Guard [Logger]
+}
+
+// Result of one URL of [Pool.Run]
+type PoolResult struct {
+	UrlStr    string     `json:"UrlStr"`
+	IInfoList IInfoList  `json:"IInfoList"`
+	Report    *RunReport `json:"Report,omitempty"`
+	Err       error      `json:"Err"`
+}
+
+// Marshal [PoolResult.Err] as text, as error values marshal to `{}`
+func (r PoolResult) MarshalJSON() ([]byte, error) {
+	type result PoolResult
+	return json.Marshal(&struct {
+		*result
+		Err string `json:"Err,omitempty"`
+	}{(*result)(&r), errStr(r.Err)})
+}
+
+// Results of [Pool.Run], in order of URLs
+type PoolResults []*PoolResult
+
+// Return infos of all URLs, in order
+func (t PoolResults) IInfoList() (list IInfoList) {
+	for _, r := range t {
+		list = append(list, r.IInfoList...)
+	}
+	return list
+}
+
+// Return errors of all URLs joined, or nil
+func (t PoolResults) Err() (err error) {
+	for _, r := range t {
+		if r.Err != nil {
+			err = errors.Join(err, fmt.Errorf("%s: %w", r.UrlStr, r.Err))
+		}
+	}
+	return err
+}
+
+// Process [urls] concurrently, limited by [Concurrency] and [PerDomain]
+//
+// A failed URL does not stop others. Canceling [ctx] stops all.
+// All URLs fail if [Property] has stateful fields which cannot be shared. See [ProcessorFactory].
+func (t *Pool) Run(ctx context.Context, urls []string) PoolResults {
+	prefix := "is.Pool.Run"
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	concurrency := t.Concurrency
+	if concurrency <= 0 {
+		concurrency = PoolConcurrencyDefault
+	}
+	var (
+		results = make(PoolResults, len(urls))
+		sinks   = make([]ISink, len(t.Property.Sinks))
+		slots   = make(chan struct{}, concurrency)
+		domains = make(map[string]chan struct{})
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+	)
+	// sinks are shared by all URLs
+	for i, sink := range t.Property.Sinks {
+		sinks[i] = NewSyncSink(sink)
+	}
+	// Return semaphore of [host]
+	domain := func(host string) chan struct{} {
+		mu.Lock()
+		defer mu.Unlock()
+		if domains[host] == nil {
+			domains[host] = make(chan struct{}, t.PerDomain)
+		}
+		return domains[host]
+	}
+	errShared := t.checkShared()
+	for i, u := range urls {
+		results[i] = &PoolResult{UrlStr: u, IInfoList: IInfoList{}}
+		if errShared != nil {
+			results[i].Err = errShared
+			continue
+		}
+		parsed, err := url.Parse(u)
+		if err == nil && parsed.Host == "" {
+			err = errors.New("no host")
+		}
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		wg.Go(func() {
+			// domain slot first, so a waiting URL does not hold a global slot
+			if t.PerDomain > 0 {
+				sem := domain(parsed.Hostname())
+				if !acquire(ctx, sem) {
+					results[i].Err = ctx.Err()
+					return
+				}
+				defer func() { <-sem }()
+			}
+			if !acquire(ctx, slots) {
+				results[i].Err = ctx.Err()
+				return
+			}
+			defer func() { <-slots }()
+			t.log(func(l *ezlog.EzLog) { l.Debug().N(prefix).N("start").M(u).Out() })
+			t.run(ctx, results[i], sinks)
+			t.log(func(l *ezlog.EzLog) { l.Debug().N(prefix).N("end").N(u).M(results[i].Err).Out() })
+		})
+	}
+	wg.Wait()
+	return results
+}
+
+// Return an error if [Property] has stateful fields, which would be shared by all URLs
+func (t *Pool) checkShared() error {
+	var fields []string
+	for _, f := range []struct {
+		name string
+		set  bool
+	}{
+		{"StopCond", t.Property.StopCond != nil},
+		{"LabelProcess", t.Property.LabelProcess != nil},
+		{"CheckpointFile", t.Property.CheckpointFile != ""},
+		{"WatermarkFile", t.Property.WatermarkFile != ""},
+		{"RecordFile", t.Property.RecordFile != ""},
+	} {
+		if f.set {
+			fields = append(fields, f.name)
+		}
+	}
+	if len(fields) > 0 {
+		return errors.New("Property " + strings.Join(fields, ", ") + " cannot be shared by URLs. Set per URL in Factory, or use Property.Stop")
+	}
+	return nil
+}
+
+// Call [f] with [Logger], one call at a time
+func (t *Pool) log(f func(l *ezlog.EzLog)) {
+	if t.Logger != nil {
+		t.logMu.Lock()
+		defer t.logMu.Unlock()
+		f(t.Logger)
+	}
+}
+
+// Take a slot of [sem], false if [ctx] is done first
+func acquire(ctx context.Context, sem chan struct{}) bool {
+	select {
+	case sem <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Open a tab and run a processor for [r.UrlStr], writing to [sinks]
+func (t *Pool) run(ctx context.Context, r *PoolResult, sinks []ISink) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			r.Err = panicErr(rec)
+		}
+	}()
+	var (
+		err       error
+		page      IPage
+		closePage func() error
+	)
+	if t.Factory == nil {
+		err = errors.New("Factory is nil")
+	}
+	if err == nil {
+		page, closePage, err = t.newPage(r.UrlStr)
+	}
+	if err != nil {
+		r.Err = err
+		return
+	}
+	defer func() {
+		if err := closePage(); err != nil && r.Err == nil {
+			r.Err = err
+		}
+	}()
+	property := t.Property
+	property.Page = page
+	property.UrlStr = r.UrlStr
+	property.UrlLoad = true
+	property.IInfoList = &r.IInfoList
+	property.Container = nil
+	if len(sinks) > 0 {
+		property.Sinks = sinks
+	}
+	p := t.Factory(&property)
+	if p == nil {
+		r.Err = errors.New("Factory returned nil")
+		return
+	}
+	if p.Err == nil {
+		r.Report = p.RunContext(ctx)
+	}
+	r.Err = p.Err
+}
+
+// Open a tab with [NewPage], or [Browser]
+func (t *Pool) newPage(url string) (page IPage, closePage func() error, err error) {
+	if t.NewPage != nil {
+		return t.NewPage(url)
+	}
+	if t.Browser == nil {
+		return nil, nil, errors.New("Browser and NewPage are nil")
+	}
+	var rp *RodPage
+	if rp, err = t.Browser.NewPage(""); err != nil {
+		return nil, nil, err
+	}
+	return rp, func() error { return t.Browser.ClosePage(rp) }, nil
+}
@@ -0,0 +1,186 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package is_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/J-Siu/go-is/v3/is/fake"
+)
+
+// Sink collecting infos, not safe for concurrent use
+type listSink struct{ list is.IInfoList }
+
+func (s *listSink) Write(info is.IInfo) error { s.list = append(s.list, info); return nil }
+func (s *listSink) Close() error              { return nil }
+
+// Run with `go test -race`
+func TestPoolRun(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		running  = make(map[string]int) // host -> processors in V020
+		maxHost  = make(map[string]int)
+		total    int
+		maxTotal int
+	)
+	enter := func(host string) {
+		mu.Lock()
+		defer mu.Unlock()
+		total++
+		running[host]++
+		maxTotal = max(maxTotal, total)
+		maxHost[host] = max(maxHost[host], running[host])
+	}
+	leave := func(host string) {
+		mu.Lock()
+		defer mu.Unlock()
+		total--
+		running[host]--
+	}
+	urls := []string{
+		"http://a.com/1", "http://a.com/2", "http://a.com/3", "http://a.com/4",
+		"http://b.com/1", "http://b.com/2", "http://b.com/3",
+		"http://c.com/1",
+		"::bad",
+	}
+	// url -> page closed. Not guarded, so the race detector still sees unsynchronized sink writes.
+	closed := make(map[string]*bool)
+	for _, u := range urls {
+		closed[u] = new(bool)
+	}
+	sink := new(listSink)
+	pool := &is.Pool{
+		Concurrency: 3,
+		PerDomain:   2,
+		Property:    is.Property{Sinks: []is.ISink{sink}},
+		NewPage: func(u string) (is.IPage, func() error, error) {
+			page := fake.New(fake.Snapshot{"article": {el(u + "#1"), el(u + "#2")}})
+			return page, func() error { *closed[u] = true; return nil }, nil
+		},
+		Factory: func(property *is.Property) *is.Processor {
+			p := newTestProcessor(property)
+			u, _ := url.Parse(property.UrlStr)
+			v020 := p.V020_Elements
+			p.V020_Elements = func() {
+				enter(u.Hostname())
+				time.Sleep(10 * time.Millisecond)
+				leave(u.Hostname())
+				v020()
+			}
+			return p
+		},
+	}
+	results := pool.Run(context.Background(), urls)
+
+	if maxTotal > pool.Concurrency {
+		t.Errorf("max concurrent = %d, want <= %d", maxTotal, pool.Concurrency)
+	}
+	for host, n := range maxHost {
+		if n > pool.PerDomain {
+			t.Errorf("max concurrent of %s = %d, want <= %d", host, n, pool.PerDomain)
+		}
+	}
+	if len(results) != len(urls) {
+		t.Fatalf("%d results, want %d", len(results), len(urls))
+	}
+	for i, r := range results[:len(urls)-1] {
+		if r.Err != nil {
+			t.Errorf("%s: %v", r.UrlStr, r.Err)
+		}
+		if got, want := users(&r.IInfoList), []string{urls[i] + "#1", urls[i] + "#2"}; !slices.Equal(got, want) {
+			t.Errorf("%s: infos = %v, want %v", r.UrlStr, got, want)
+		}
+	}
+	if results[len(urls)-1].Err == nil {
+		t.Error("invalid URL: Err = nil")
+	}
+	if n := len(results.IInfoList()); len(sink.list) != n {
+		t.Errorf("sink got %d infos, want %d", len(sink.list), n)
+	}
+	for _, u := range urls[:len(urls)-1] {
+		if !*closed[u] {
+			t.Errorf("%s: page not closed", u)
+		}
+	}
+}
+
+// Run with `go test -race`
+func TestPoolStop(t *testing.T) {
+	urls := []string{"http://a.com/1", "http://a.com/2", "http://b.com/1", "http://c.com/1", "::bad"}
+	pool := &is.Pool{
+		Concurrency: 4,
+		Property:    is.Property{ScrollMax: -1, StopCond: &is.StopNoNewElements{N: 1}},
+		NewPage: func(u string) (is.IPage, func() error, error) {
+			page := fake.New(
+				fake.Snapshot{"article": {el(u + "#1")}},
+				fake.Snapshot{"article": {el(u + "#1"), el(u + "#2")}},
+			)
+			return page, func() error { return nil }, nil
+		},
+		Factory: newTestProcessor,
+	}
+
+	// stateful StopCond of the template would be shared by all URLs
+	for _, r := range pool.Run(context.Background(), urls) {
+		if r.Err == nil || !strings.Contains(r.Err.Error(), "StopCond") {
+			t.Errorf("%s: Err = %v, want shared StopCond error", r.UrlStr, r.Err)
+		}
+	}
+
+	// serializable Stop is built per processor
+	pool.Property.StopCond = nil
+	pool.Property.Stop = &is.StopSpec{Kind: "NoNewElements", N: 1}
+	results := pool.Run(context.Background(), urls)
+	for i, r := range results[:len(urls)-1] {
+		if r.Err != nil {
+			t.Errorf("%s: %v", r.UrlStr, r.Err)
+			continue
+		}
+		if got, want := users(&r.IInfoList), []string{urls[i] + "#1", urls[i] + "#2"}; !slices.Equal(got, want) {
+			t.Errorf("%s: infos = %v, want %v", r.UrlStr, got, want)
+		}
+		// load, #2 found, then 1 iteration without new element
+		if r.Report.ScrollCount != 3 {
+			t.Errorf("%s: ScrollCount = %d, want 3", r.UrlStr, r.Report.ScrollCount)
+		}
+	}
+
+	b, err := json.Marshal(results[len(urls)-1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got struct{ Err string }
+	if err = json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Err != results[len(urls)-1].Err.Error() {
+		t.Errorf("JSON Err = %q, want %q", got.Err, results[len(urls)-1].Err)
+	}
+}
@@ -23,15 +23,13 @@ THE SOFTWARE.
 // Package [is] is an infinite scroll processor using [runZeroInc/go-rod](https://github.com/runZeroInc/go-rod).
 package is
 
-import (
-	"github.com/runZeroInc/go-rod"
-)
+import "time"
 
 type Property struct {
-	// -- [rod] element
+	// -- Page and element
 
-	Page      *rod.Page    `json:"Page,omitempty"`      // REQUIRED: Page element of [rod].
-	Container *rod.Element `json:"Container,omitempty"` // The outer most rod.Element containing all repeating items
+	Page      IPage    `json:"Page,omitempty"`      // REQUIRED: Page to process. eg. `&is.RodPage{Rod: page}`
+	Container IElement `json:"Container,omitempty"` // The outer most element containing all repeating items. Also the scroll target of [ScrollMode] other than [ScrollModeElement].
 
 	// -- URL
 
@@ -41,9 +39,58 @@ type Property struct {
 
 	// -- Flow control
 
-	ScrollMax int `json:"ScrollMax,omitempty"` // Maximum time the page should be scrolled
+	ScrollMax int       `json:"ScrollMax,omitempty"` // Maximum time the page should be scrolled
+	StopCond  IStop     `json:"-"`                   // Additional stop condition checked by [Processor.ScrollLoop]. eg. [StopAny](&[StopNoNewElements]{N: 3}, &[StopMaxDuration]{D: time.Hour})
+	Stop      *StopSpec `json:"Stop,omitempty"`      // Serializable [StopCond], built by [Processor.New] if [StopCond] is nil
+
+	// -- Wait strategy
+
+	WaitLoad   IWait `json:"-"` // Wait after [UrlStr] is loaded. (default: [WaitDefault])
+	WaitScroll IWait `json:"-"` // Wait after scrolling or advancing. (default: [WaitDefault]). eg. &[WaitSleep]{D: time.Second, Jitter: time.Second}
+
+	// -- Scrolling
+
+	Direction    ScrollDirection `json:"Direction,omitempty"`    // [ScrollDown] or [ScrollUp]. (default: [ScrollDown])
+	ScrollMode   ScrollMode      `json:"ScrollMode,omitempty"`   // How to scroll. (default: [ScrollModeElement])
+	ScrollStep   float64         `json:"ScrollStep,omitempty"`   // Fraction of visible height to scroll in [ScrollModeViewport]. (default: [ScrollStepDefault])
+	ScrollPixels int             `json:"ScrollPixels,omitempty"` // Pixels to scroll in [ScrollModePixels]. Must be > 0 in that mode
+	Virtualized  bool            `json:"Virtualized,omitempty"`  // Virtualized/recycled list. All elements are checked every iteration, deduplicated by key. Default to [ScrollModeViewport].
+
+	// -- Advance, other than scrolling
+
+	AdvanceMode     AdvanceMode   `json:"AdvanceMode,omitempty"`     // [AdvanceScroll], [AdvanceClick] or [AdvanceNextLink]. (default: [AdvanceScroll])
+	AdvanceSelector string        `json:"AdvanceSelector,omitempty"` // Css selector of the "Show more" button or "Next" link. Not used by [AdvanceScroll]
+	AdvanceTimeout  time.Duration `json:"AdvanceTimeout,omitempty"`  // Maximum wait for the button to be enabled and clicked in [AdvanceClick]. (default: [AdvanceTimeoutDefault])
+
+	// -- Checkpoint
+
+	CheckpointFile   string `json:"CheckpointFile,omitempty"`   // Save collected infos, seen keys and last processed key to this file. Removed when a run completes without error. Empty to disable.
+	CheckpointEvery  int    `json:"CheckpointEvery,omitempty"`  // Save every N scroll iterations, and at the end of run. 0 = end of run only.
+	CheckpointResume bool   `json:"CheckpointResume,omitempty"` // Load [CheckpointFile] if exists, and fast-forward to the last processed element. Requires element keys.
+
+	// -- Incremental run
+
+	WatermarkFile    string `json:"WatermarkFile,omitempty"`    // Keys and newest [IInfoTime] of previous runs. Known items are skipped. Updated at the end of a run without error. Empty to disable.
+	WatermarkMaxKeys int    `json:"WatermarkMaxKeys,omitempty"` // Maximum keys kept in [WatermarkFile]. (default: [WatermarkMaxKeysDefault])
+
+	// -- Error handling
+
+	ErrPolicy   ErrPolicy `json:"ErrPolicy,omitempty"`   // Action when a stage panics or fails. (default: [ErrPolicyAbort])
+	ErrRetryMax int       `json:"ErrRetryMax,omitempty"` // Maximum retries of a failed stage with [ErrPolicyRetry]
+
+	// -- Batch extraction
+
+	ExtractJS  string       `json:"ExtractJS,omitempty"` // JS function `(el) => object`, run on all new elements with one [IPage.ExtractJS] call per iteration, replacing V030. eg. `el => ({User: el.querySelector("a")?.innerText})`
+	ExtractNew func() IInfo `json:"-"`                   // Return a new info struct to unmarshal [ExtractJS] result into. Required with [ExtractJS]
+
+	// -- Record
+
+	RecordFile string `json:"RecordFile,omitempty"` // Save outer HTML of [Container] or elements, and [State], of each iteration. See [Recording] and [Processor.Replay]
 
 	// -- Information collection
 
 	IInfoList *IInfoList `json:"IInfoList,omitempty"` // Pointer of array of IInfo. If not nil, IInfo item will be added to the array
+	Sinks     []ISink    `json:"-"`                   // Each IInfo item is written to all sinks during [Processor.Run]. Sinks are not closed by [Processor.Run].
+
+	LabelProcess map[string]ProcessorFunc `json:"-"` // Hook per label, run after V050/V060 for each label of [IInfoLabels] info. eg. write "spam" items to another sink
 }
@@ -0,0 +1,342 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package is
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"os"
+	"strings"
+	"time"
+)
+
+// Snapshots of a run, saved to [Property.RecordFile] and fed back by [Processor.Replay]
+//
+// [Frame.Infos] items must be registered with [RegisterInfo] to be loaded back.
+type Recording struct {
+	Time   time.Time `json:"Time"`
+	UrlStr string    `json:"UrlStr"`
+	Frames []*Frame  `json:"Frames"` // One per scroll iteration
+}
+
+// Snapshot of one scroll iteration
+type Frame struct {
+	ScrollCount int       `json:"ScrollCount"`
+	Container   string    `json:"Container,omitempty"` // Outer HTML of [Container] after [Processor.V020_Elements]
+	Elements    []string  `json:"Elements,omitempty"`  // Outer HTML of [State.Elements], if [Container] is nil
+	State       *State    `json:"State"`               // [State] at the end of the iteration, without elements
+	Infos       IInfoList `json:"Infos"`               // Infos collected in the iteration, if [Property.IInfoList] is set
+
+	infoFrom int // Length of [IInfoList] at start of iteration
+}
+
+// Load recording from [file]
+func LoadRecording(file string) (r *Recording, err error) {
+	var b []byte
+	b, err = os.ReadFile(file)
+	if err == nil {
+		r = new(Recording)
+		err = json.Unmarshal(b, r)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("LoadRecording: %w", err)
+	}
+	return r, nil
+}
+
+// Save recording to [file]. File is replaced atomically.
+func (r *Recording) Save(file string) (err error) {
+	var b []byte
+	b, err = json.Marshal(r)
+	if err == nil {
+		err = writeFileAtomic(file, b)
+	}
+	if err != nil {
+		err = fmt.Errorf("Recording.Save: %w", err)
+	}
+	return err
+}
+
+// Return infos of all frames, in order
+func (r *Recording) Infos() (list IInfoList) {
+	for _, f := range r.Frames {
+		list = append(list, f.Infos...)
+	}
+	return list
+}
+
+// Return HTML document of frame [i], with [UrlStr] as base URL
+func (r *Recording) Document(i int) string {
+	f := r.Frames[i]
+	var b strings.Builder
+	b.WriteString(`<!DOCTYPE html><html><head><meta charset="utf-8">`)
+	if r.UrlStr != "" {
+		b.WriteString(`<base href="` + html.EscapeString(r.UrlStr) + `">`)
+	}
+	b.WriteString(`</head><body>`)
+	if f.Container != "" {
+		b.WriteString(f.Container)
+	} else {
+		for _, e := range f.Elements {
+			b.WriteString(e)
+		}
+	}
+	b.WriteString(`</body></html>`)
+	return b.String()
+}
+
+// Start a frame with outer HTML of [Container] or [StateCurr.Elements]
+func (t *Processor) record() {
+	prefix := t.MyType + ".Record"
+	t.StateCurr.Name = prefix
+	var err error
+	f := &Frame{ScrollCount: t.StateCurr.ScrollCount, infoFrom: t.infoCount()}
+	if t.Container != nil {
+		f.Container, err = t.Container.HTML()
+	} else {
+		f.Elements = make([]string, len(t.StateCurr.Elements))
+		for i, e := range t.StateCurr.Elements {
+			if f.Elements[i], err = e.HTML(); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		t.Err = fmt.Errorf("%s: %w", prefix, err)
+		return
+	}
+	t.recording.Frames = append(t.recording.Frames, f)
+	if t.Logger != nil {
+		t.Logger.Trace().N(prefix).N("frame").M(len(t.recording.Frames)).Out()
+	}
+}
+
+// Finish current frame with a copy of [StateCurr] and infos collected since [record]
+func (t *Processor) recordEnd() {
+	if len(t.recording.Frames) == 0 {
+		return
+	}
+	f := t.recording.Frames[len(t.recording.Frames)-1]
+	if f.State != nil {
+		return
+	}
+	s := *t.StateCurr
+	s.Base, s.Logger = nil, nil
+	s.Elements, s.ElementKeys, s.ElementsNewIndex, s.BatchInfos = nil, nil, nil, nil
+	s.Element, s.ScrollableElement, s.AdvanceElement = nil, nil, nil
+	s.ElementInfo, s.ScrollableElementInfo = nil, nil
+	f.State = &s
+	if n := t.infoCount() - f.infoFrom; n > 0 {
+		list := *t.IInfoList
+		if t.Direction == ScrollUp {
+			f.Infos = append(IInfoList{}, list[:n]...)
+		} else {
+			f.Infos = append(IInfoList{}, list[f.infoFrom:]...)
+		}
+	}
+}
+
+// Finish last frame and save [recording] to [RecordFile]
+func (t *Processor) recordSave() (err error) {
+	prefix := t.MyType + ".RecordSave"
+	t.recordEnd()
+	if err = t.recording.Save(t.RecordFile); err != nil {
+		err = fmt.Errorf("%s: %w", prefix, err)
+	} else if t.Logger != nil {
+		t.Logger.Debug().N(prefix).N(t.RecordFile).M(len(t.recording.Frames)).Out()
+	}
+	return err
+}
+
+// -- Replay
+
+// [IPage] rendering frames of [Recording] in [IPage], one per scroll iteration
+//
+// The first [Navigate] renders the first frame. Each scroll, click or further navigation renders the next frame.
+// Advance buttons/links are not recorded, and stay on the last frame.
+type ReplayPage struct {
+	IPage // Page to render frames in. eg. a blank tab
+
+	*Replay // Shared by copies of the page returned by [ReplayPage.Context]
+}
+
+// Progress of a [ReplayPage]
+type Replay struct {
+	Recording *Recording
+	Frame     int // Index of current frame. -1 before first [Navigate]
+
+	// Render HTML document in page. (default: [rod.Page.SetDocumentContent] if page is a [RodPage])
+	Render func(page IPage, html string) error
+}
+
+// Return a page replaying [r] in [page]
+func NewReplayPage(page IPage, r *Recording) *ReplayPage {
+	return &ReplayPage{IPage: page, Replay: &Replay{Recording: r, Frame: -1}}
+}
+
+// Render frame [i]
+func (p *ReplayPage) render(i int) (err error) {
+	if i >= len(p.Recording.Frames) {
+		return nil // stay on last frame
+	}
+	p.Frame = i
+	doc := p.Recording.Document(i)
+	if p.Render != nil {
+		return p.Render(p.IPage, doc)
+	}
+	if rp, ok := p.IPage.(*RodPage); ok {
+		return rp.Rod.SetDocumentContent(doc)
+	}
+	return errors.New("ReplayPage: Render is nil and page is not a RodPage")
+}
+
+// Render next frame
+func (p *ReplayPage) Next() error { return p.render(p.Frame + 1) }
+
+func (p *ReplayPage) Context(ctx context.Context) IPage {
+	return &ReplayPage{IPage: p.IPage.Context(ctx), Replay: p.Replay}
+}
+
+// Render first frame on first call, next frame afterward. [url] is ignored.
+func (p *ReplayPage) Navigate(url string) error { return p.Next() }
+
+func (p *ReplayPage) Element(selector string) (IElement, error) {
+	e, err := p.IPage.Element(selector)
+	return p.wrap(e), err
+}
+
+func (p *ReplayPage) Elements(selector string) ([]IElement, error) {
+	es, err := p.IPage.Elements(selector)
+	return p.wrapList(es), err
+}
+
+func (p *ReplayPage) Has(selector string) (bool, IElement, error) {
+	found, e, err := p.IPage.Has(selector)
+	return found, p.wrap(e), err
+}
+
+func (p *ReplayPage) Keys(elements []IElement) ([]string, error) {
+	return p.IPage.Keys(unwrapList(elements))
+}
+
+func (p *ReplayPage) ExtractJS(fn string, elements []IElement) ([]json.RawMessage, error) {
+	return p.IPage.ExtractJS(fn, unwrapList(elements))
+}
+
+// Render next frame unless [mode] is [ScrollModeElement]. Return scroll height of rendered frame.
+func (p *ReplayPage) Scroll(container IElement, mode ScrollMode, step float64, pixels int, dir ScrollDirection) (int, error) {
+	if mode != ScrollModeElement {
+		if err := p.Next(); err != nil {
+			return 0, err
+		}
+	}
+	if container != nil {
+		container = unwrap(container)
+	}
+	return p.IPage.Scroll(container, ScrollModeElement, step, pixels, dir)
+}
+
+func (p *ReplayPage) WaitDOMStable(d time.Duration, diff float64) error { return p.GetContext().Err() }
+func (p *ReplayPage) WaitIdle(d time.Duration) error                    { return p.GetContext().Err() }
+
+// Element of [ReplayPage]. Scrolling or clicking it renders the next frame.
+type replayElement struct {
+	IElement
+	page *ReplayPage
+}
+
+func (p *ReplayPage) wrap(e IElement) IElement {
+	if e == nil {
+		return nil
+	}
+	return &replayElement{IElement: e, page: p}
+}
+
+func (p *ReplayPage) wrapList(es []IElement) []IElement {
+	for i, e := range es {
+		es[i] = p.wrap(e)
+	}
+	return es
+}
+
+func unwrap(e IElement) IElement {
+	if re, ok := e.(*replayElement); ok {
+		return re.IElement
+	}
+	return e
+}
+
+func unwrapList(es []IElement) []IElement {
+	list := make([]IElement, len(es))
+	for i, e := range es {
+		list[i] = unwrap(e)
+	}
+	return list
+}
+
+func (e *replayElement) Context(ctx context.Context) IElement {
+	return &replayElement{IElement: e.IElement.Context(ctx), page: e.page}
+}
+
+func (e *replayElement) Element(selector string) (IElement, error) {
+	c, err := e.IElement.Element(selector)
+	return e.page.wrap(c), err
+}
+
+func (e *replayElement) Elements(selector string) ([]IElement, error) {
+	es, err := e.IElement.Elements(selector)
+	return e.page.wrapList(es), err
+}
+
+func (e *replayElement) ScrollIntoView() error { return e.page.Next() }
+func (e *replayElement) Click() error          { return e.page.Next() }
+
+// Feed frames of [page] back through [t] offline. eg. `t.Replay(ctx, is.NewReplayPage(&is.RodPage{Rod: blankTab}, recording))`
+//
+// [Property] is restored afterward. During replay, advance mode is [AdvanceScroll], [ScrollMax] is the number of frames,
+// waits are skipped, and [StopCond], checkpoint, watermark and recording are disabled. [Seen] and [Known] are reset.
+// [IInfoList], if not nil, is emptied first, so it holds only replayed infos afterward.
+// Compare it with [Recording.Infos] to regression-test extraction.
+func (t *Processor) Replay(ctx context.Context, page *ReplayPage) *RunReport {
+	saved := t.Property
+	defer func() { t.Property = saved }()
+	page.Frame = -1
+	t.Page = page
+	t.Container = nil
+	t.UrlLoad = true
+	t.ScrollMax = len(page.Recording.Frames) - 1
+	t.StopCond = nil
+	t.WaitLoad, t.WaitScroll = &WaitSleep{}, &WaitSleep{}
+	t.AdvanceMode = AdvanceScroll
+	t.CheckpointFile, t.WatermarkFile, t.RecordFile = "", "", ""
+	t.StateCurr = new(State).New(0)
+	t.StatePrev = nil
+	t.Seen, t.Known = nil, nil
+	if t.IInfoList != nil {
+		*t.IInfoList = nil
+	}
+	return t.RunContext(ctx)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package is
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sync"
+)
+
+// Info type registry, for unmarshalling [IInfoList]
+var infoRegistry = struct {
+	sync.RWMutex
+	byName map[string]reflect.Type
+	byType map[reflect.Type]string
+}{
+	byName: make(map[string]reflect.Type),
+	byType: make(map[reflect.Type]string),
+}
+
+// Register info struct type of [info] with [name], used as type discriminator when marshalling [IInfoList]
+//
+// [info] must be a pointer to struct. eg. `is.RegisterInfo("xf", new(XFeedInfo))`. Call it in `init()`.
+func RegisterInfo(name string, info IInfo) {
+	typ := reflect.TypeOf(info)
+	if typ == nil || typ.Kind() != reflect.Pointer || typ.Elem().Kind() != reflect.Struct {
+		panic("RegisterInfo: " + name + ": info must be a pointer to struct")
+	}
+	infoRegistry.Lock()
+	defer infoRegistry.Unlock()
+	infoRegistry.byName[name] = typ
+	infoRegistry.byType[typ] = name
+}
+
+// Return registered name of [info] type, or its Go type name if not registered
+func InfoTypeName(info IInfo) string {
+	typ := reflect.TypeOf(info)
+	infoRegistry.RLock()
+	defer infoRegistry.RUnlock()
+	if name, ok := infoRegistry.byType[typ]; ok {
+		return name
+	}
+	return typ.String()
+}
+
+// Return a new info of registered [name]
+func NewInfo(name string) (info IInfo, err error) {
+	infoRegistry.RLock()
+	typ, ok := infoRegistry.byName[name]
+	infoRegistry.RUnlock()
+	if !ok {
+		return nil, errors.New("info type not registered: " + name)
+	}
+	return reflect.New(typ.Elem()).Interface().(IInfo), nil
+}
+
+// JSON envelope of an [IInfoList] item
+type infoJSON struct {
+	Type string          `json:"Type"`
+	Info json.RawMessage `json:"Info"`
+}
+
+// Marshal as array of `{"Type": name, "Info": {...}}`
+//
+// Name is from [RegisterInfo]. Unregistered types use their Go type name, and cannot be unmarshalled.
+func (t IInfoList) MarshalJSON() ([]byte, error) {
+	items := make([]infoJSON, 0, len(t))
+	for _, info := range t {
+		if info == nil {
+			continue
+		}
+		b, err := json.Marshal(info)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, infoJSON{Type: InfoTypeName(info), Info: b})
+	}
+	return json.Marshal(items)
+}
+
+// Unmarshal array produced by [IInfoList.MarshalJSON] into registered info struct types
+func (t *IInfoList) UnmarshalJSON(b []byte) error {
+	var items []infoJSON
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+	list := make(IInfoList, 0, len(items))
+	for _, item := range items {
+		info, err := NewInfo(item.Type)
+		if err == nil {
+			err = json.Unmarshal(item.Info, info)
+		}
+		if err != nil {
+			return errors.New("IInfoList.UnmarshalJSON: " + err.Error())
+		}
+		list = append(list, info)
+	}
+	*t = list
+	return nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package is
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Error journal entry of a failed stage
+type ErrRecord struct {
+	*StageError           // Stage, element index, scroll count and underlying error
+	Policy      ErrPolicy `json:"Policy"` // Action taken by [Processor.ErrorPolicy]
+	Info        IInfo     `json:"Info"`   // Partial [State.ElementInfo] when error occurred. `nil` if not available
+}
+
+// Marshal embedded [StageError] fields as text, along with [ErrRecord.Policy] and [ErrRecord.Info]
+func (r ErrRecord) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		stageErrorJSON
+		Policy ErrPolicy `json:"Policy"`
+		Info   IInfo     `json:"Info"`
+	}{r.StageError.toJSON(), r.Policy, r.Info})
+}
+
+// Summary of a [Processor.Run]
+type RunReport struct {
+	TimeStart     time.Time      `json:"TimeStart"`
+	TimeEnd       time.Time      `json:"TimeEnd"`
+	ElementsSeen  int            `json:"ElementsSeen"`  // Number of elements entered element loop
+	Infos         int            `json:"Infos"`         // Number of non-nil info returned by V030
+	Duplicates    int            `json:"Duplicates"`    // Number of info skipped as their [IInfoKey] was seen
+	Detached      int            `json:"Detached"`      // Number of elements skipped as they were detached from DOM
+	Known         int            `json:"Known"`         // Number of items skipped as they were seen by a previous run. See [Property.WatermarkFile]
+	Matched       int            `json:"Matched"`       // Number of info matched by V040
+	Unmatched     int            `json:"Unmatched"`     // Number of info not matched by V040
+	Labels        map[string]int `json:"Labels"`        // Number of info per label. See [IInfoLabels]
+	Errors        int            `json:"Errors"`        // Total number of [ErrRecord] in [Processor.ErrJournal]
+	ErrorsByStage map[string]int `json:"ErrorsByStage"` // Number of [ErrRecord] per stage
+	ScrollCount   int            `json:"ScrollCount"`   // Number of scroll loop iterations
+	Err           error          `json:"Err"`           // [Processor.Err] at the end of run
+}
+
+func (r *RunReport) New() *RunReport {
+	r.TimeStart = time.Now()
+	r.ErrorsByStage = make(map[string]int)
+	r.Labels = make(map[string]int)
+	return r
+}
+
+// Marshal [RunReport.Err] as text, as error values marshal to `{}`
+func (r RunReport) MarshalJSON() ([]byte, error) {
+	type report RunReport
+	return json.Marshal(&struct {
+		*report
+		Err string `json:"Err,omitempty"`
+	}{(*report)(&r), errStr(r.Err)})
+}
+
+// Duration of the run
+func (r *RunReport) Duration() time.Duration { return r.TimeEnd.Sub(r.TimeStart) }
+
+// Ratio of [Infos] to [ElementsSeen]. 1 if no element seen.
+func (r *RunReport) InfoRatio() float64 {
+	if r.ElementsSeen == 0 {
+		return 1
+	}
+	return float64(r.Infos) / float64(r.ElementsSeen)
+}
+
+// Add [rec] to [Processor.ErrJournal] and update [Processor.Report]
+func (t *Processor) journal(rec ErrRecord) {
+	t.ErrJournal = append(t.ErrJournal, rec)
+	if t.Report != nil {
+		t.Report.Errors++
+		t.Report.ErrorsByStage[rec.Stage]++
+	}
+}
@@ -0,0 +1,237 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package is
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/runZeroInc/go-rod"
+	"github.com/runZeroInc/go-rod/lib/proto"
+	"github.com/runZeroInc/go-rod/pkg/gson"
+)
+
+// Returned when an [IElement] given to [RodPage] is not a [RodElement]
+var ErrNotRodElement = errors.New("not a rod element")
+
+// [IPage] backed by [rod.Page]
+type RodPage struct {
+	Rod *rod.Page
+}
+
+// [IElement] backed by [rod.Element]
+type RodElement struct {
+	Rod *rod.Element
+}
+
+// Return [es] as [IElement]s. eg. in [Processor.V020_Elements]
+func RodElements(es rod.Elements) []IElement {
+	elements := make([]IElement, len(es))
+	for i, e := range es {
+		elements[i] = &RodElement{Rod: e}
+	}
+	return elements
+}
+
+// Return [e] as [IElement], `nil` if [e] is nil
+func rodElement(e *rod.Element) IElement {
+	if e == nil {
+		return nil
+	}
+	return &RodElement{Rod: e}
+}
+
+// Return remote objects of [elements] as JS call arguments
+func rodObjects(elements []IElement) (args []any, err error) {
+	args = make([]any, len(elements))
+	for i, e := range elements {
+		re, ok := e.(*RodElement)
+		if !ok {
+			return nil, ErrNotRodElement
+		}
+		args[i] = re.Rod.Object
+	}
+	return args, nil
+}
+
+// -- RodPage
+
+func (p *RodPage) Context(ctx context.Context) IPage { return &RodPage{Rod: p.Rod.Context(ctx)} }
+func (p *RodPage) GetContext() context.Context       { return p.Rod.GetContext() }
+func (p *RodPage) Navigate(url string) error         { return p.Rod.Navigate(url) }
+
+func (p *RodPage) Element(selector string) (IElement, error) {
+	e, err := p.Rod.Element(selector)
+	return rodElement(e), err
+}
+
+func (p *RodPage) Elements(selector string) ([]IElement, error) {
+	es, err := p.Rod.Elements(selector)
+	return RodElements(es), err
+}
+
+func (p *RodPage) Has(selector string) (bool, IElement, error) {
+	found, e, err := p.Rod.Has(selector)
+	return found, rodElement(e), err
+}
+
+// JS returning DOM fingerprint of each element argument
+//
+// Fingerprint is built from stable identity, the first found of:
+//   - element id
+//   - `data-*id` attribute (eg. `data-id`, `data-post-id`), excluding `data-testid`
+//   - permalink href (link wrapping a `time` element)
+//
+// Link hrefs and collapsed text content are used only when none is found, as text changes with relative timestamps and counters.
+const jsElementKeys = `(...els) => els.map(el => {
+	const hash = s => { let h = 5381; for (let i = 0; i < s.length; i++) h = ((h << 5) + h + s.charCodeAt(i)) | 0; return (h >>> 0).toString(36) }
+	if (!el || !el.isConnected) return ""
+	const tag = el.tagName + ":"
+	if (el.id) return tag + "id:" + el.id
+	for (const a of el.attributes) {
+		if (/^data-(.+-)?id$/i.test(a.name) && !/^data-test-?id$/i.test(a.name) && a.value) return tag + a.name + ":" + a.value
+	}
+	const href = a => a.getAttribute("href")
+	const links = Array.from(el.querySelectorAll("a[href]")).filter(a => !/^(#|javascript:)/i.test(href(a)))
+	const permalink = links.filter(a => a.querySelector("time")).map(href)
+	if (permalink.length) return tag + "href:" + hash(permalink.join(" "))
+	const text = (el.textContent || "").replace(/\s+/g, " ").trim()
+	return tag + "text:" + hash(links.map(href).join(" ")) + ":" + hash(text) + ":" + text.length
+})`
+
+// Return DOM fingerprint of [elements] with one [rod.Page.Eval]
+func (p *RodPage) Keys(elements []IElement) (keys []string, err error) {
+	if len(elements) == 0 {
+		return []string{}, nil
+	}
+	args, err := rodObjects(elements)
+	var res *proto.RuntimeRemoteObject
+	if err == nil {
+		res, err = p.Rod.Eval(jsElementKeys, args...)
+	}
+	if err == nil {
+		for _, v := range res.Value.Arr() {
+			keys = append(keys, v.Str())
+		}
+	}
+	return keys, err
+}
+
+// JS wrapper running extractor [fn] on each element argument. Errors are returned as `{"__error": message}`.
+func jsExtract(fn string) string {
+	return `(...els) => {
+	const fn = (` + fn + `)
+	return els.map(el => {
+		if (!el || !el.isConnected) return null
+		try { return fn(el) ?? null } catch (e) { return {__error: String(e)} }
+	})
+}`
+}
+
+// Run [fn] on all [elements] with one [rod.Page.Eval]
+func (p *RodPage) ExtractJS(fn string, elements []IElement) (items []json.RawMessage, err error) {
+	var (
+		b   []byte
+		res *proto.RuntimeRemoteObject
+	)
+	args, err := rodObjects(elements)
+	if err == nil {
+		res, err = p.Rod.Eval(jsExtract(fn), args...)
+	}
+	if err == nil {
+		b, err = json.Marshal(res.Value)
+	}
+	if err == nil {
+		err = json.Unmarshal(b, &items)
+	}
+	return items, err
+}
+
+// JS scrolling element argument, or document if null, base on mode and direction (1 down, -1 up). Return scroll height.
+const jsScroll = `(el, mode, step, px, dir) => {
+	const t = el || document.scrollingElement
+	const h = el ? el.clientHeight : window.innerHeight
+	switch (mode) {
+	case 1: t.scrollBy(0, dir * h * step); break
+	case 2: t.scrollBy(0, dir * px); break
+	case 3: t.scrollTop = dir > 0 ? t.scrollHeight : 0; break
+	}
+	return t.scrollHeight
+}`
+
+func (p *RodPage) Scroll(container IElement, mode ScrollMode, step float64, pixels int, dir ScrollDirection) (height int, err error) {
+	var args []any
+	if container != nil {
+		args, err = rodObjects([]IElement{container})
+	} else {
+		args = []any{nil}
+	}
+	d := 1
+	if dir == ScrollUp {
+		d = -1
+	}
+	var res *proto.RuntimeRemoteObject
+	if err == nil {
+		res, err = p.Rod.Eval(jsScroll, args[0], int(mode), step, pixels, d)
+	}
+	if err == nil {
+		height = res.Value.Int()
+	}
+	return height, err
+}
+
+func (p *RodPage) WaitDOMStable(d time.Duration, diff float64) error {
+	return p.Rod.WaitDOMStable(d, diff)
+}
+
+func (p *RodPage) WaitIdle(d time.Duration) error {
+	p.Rod.WaitRequestIdle(d, nil, nil, nil)()
+	return p.Rod.GetContext().Err()
+}
+
+// -- RodElement
+
+func (e *RodElement) Context(ctx context.Context) IElement {
+	return &RodElement{Rod: e.Rod.Context(ctx)}
+}
+
+func (e *RodElement) Element(selector string) (IElement, error) {
+	el, err := e.Rod.Element(selector)
+	return rodElement(el), err
+}
+
+func (e *RodElement) Elements(selector string) ([]IElement, error) {
+	es, err := e.Rod.Elements(selector)
+	return RodElements(es), err
+}
+
+func (e *RodElement) Text() (string, error)                   { return e.Rod.Text() }
+func (e *RodElement) HTML() (string, error)                   { return e.Rod.HTML() }
+func (e *RodElement) Attribute(name string) (*string, error)  { return e.Rod.Attribute(name) }
+func (e *RodElement) Property(name string) (gson.JSON, error) { return e.Rod.Property(name) }
+func (e *RodElement) ScrollIntoView() error                   { return e.Rod.ScrollIntoView() }
+func (e *RodElement) WaitEnabled() error                      { return e.Rod.WaitEnabled() }
+
+func (e *RodElement) Click() error { return e.Rod.Click(proto.InputMouseButtonLeft, 1) }
@@ -0,0 +1,90 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package is
+
+import (
+	"errors"
+	"fmt"
+)
+
+// How [Processor.ScrollElement] scrolls the page
+type ScrollMode int8
+
+const (
+	ScrollModeElement  ScrollMode = iota // Scroll last scrollable element into view (default)
+	ScrollModeViewport                   // Scroll [Container], or window if nil, by [Property.ScrollStep] of its visible height
+	ScrollModePixels                     // Scroll [Container], or window if nil, by [Property.ScrollPixels]
+	ScrollModeBottom                     // Scroll [Container], or window if nil, to the bottom, or top if [ScrollUp]
+)
+
+// Scroll direction of [Processor.Run]
+type ScrollDirection int8
+
+const (
+	ScrollDown ScrollDirection = iota // New elements are appended at the bottom (default)
+	ScrollUp                          // New elements are prepended at the top. eg. chat history, comment thread
+)
+
+// Default [Property.ScrollStep]
+const ScrollStepDefault = 0.8
+
+// Scroll mode in effect. [Property.Virtualized] use [ScrollModeViewport] unless another mode is set.
+func (t *Processor) scrollMode() ScrollMode {
+	if t.Virtualized && t.ScrollMode == ScrollModeElement {
+		return ScrollModeViewport
+	}
+	return t.ScrollMode
+}
+
+// Scroll [Container], or document, with [mode]
+//
+// [ScrollModeElement] does not scroll, and only return scroll height.
+func (t *Processor) scrollContainer(mode ScrollMode) (height int, err error) {
+	step := t.ScrollStep
+	if step == 0 {
+		step = ScrollStepDefault
+	}
+	if mode == ScrollModePixels && t.ScrollPixels <= 0 {
+		return 0, errors.New("ScrollPixels must be > 0 in ScrollModePixels")
+	}
+	return t.Page.Scroll(t.Container, mode, step, t.ScrollPixels, t.Direction)
+}
+
+// Return true if [Property.StopCond] reads scroll height. See [IStopHeight]
+func (t *Processor) needScrollHeight() bool {
+	s, ok := t.StopCond.(IStopHeight)
+	return ok && s.NeedScrollHeight()
+}
+
+// Update [StateCurr.ScrollHeight] and [StateCurr.ScrollHeightGrown] with scroll height of [Container], or document
+func (t *Processor) scrollHeight() {
+	prefix := t.MyType + ".ScrollHeight"
+	t.StateCurr.Name = prefix
+	height, err := t.scrollContainer(ScrollModeElement)
+	if err == nil {
+		t.StateCurr.ScrollHeight = height
+		t.StateCurr.ScrollHeightGrown = t.StatePrev != nil && height > t.StatePrev.ScrollHeight
+	} else {
+		t.Err = fmt.Errorf("%s: %w", prefix, err)
+	}
+}
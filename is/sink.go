@@ -0,0 +1,262 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package is
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Interface for info output sink
+//
+// Used incrementally during [Processor.Run] via [Property.Sinks], or on a finished list with [IInfoList.Sink].
+type ISink interface {
+	Write(info IInfo) error // Write one info
+	Close() error           // Flush and finish output. Does not close the underlying [io.Writer].
+}
+
+// Write all info in list to [sink], then close [sink]
+func (t *IInfoList) Sink(sink ISink) (err error) {
+	for _, info := range *t {
+		if err = sink.Write(info); err != nil {
+			break
+		}
+	}
+	if errClose := sink.Close(); err == nil {
+		err = errClose
+	}
+	return err
+}
+
+// -- Concurrent use
+
+// Serialize [Write] and [Close] of a sink shared by concurrent processors. eg. [Pool] wraps [Property.Sinks] with it.
+type SyncSink struct {
+	sink ISink
+	mu   sync.Mutex
+}
+
+func NewSyncSink(sink ISink) *SyncSink { return &SyncSink{sink: sink} }
+
+func (s *SyncSink) Write(info IInfo) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sink.Write(info)
+}
+
+func (s *SyncSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sink.Close()
+}
+
+// -- JSON Lines
+
+// Write one JSON object per line
+type JSONLSink struct {
+	enc *json.Encoder
+}
+
+func NewJSONLSink(w io.Writer) *JSONLSink { return &JSONLSink{enc: json.NewEncoder(w)} }
+
+func (s *JSONLSink) Write(info IInfo) error { return s.enc.Encode(info) }
+func (s *JSONLSink) Close() error           { return nil }
+
+// -- JSON array
+
+// Write a JSON array, one item per line. The array is closed by [Close].
+type JSONSink struct {
+	w     io.Writer
+	count int
+}
+
+func NewJSONSink(w io.Writer) *JSONSink { return &JSONSink{w: w} }
+
+func (s *JSONSink) Write(info IInfo) (err error) {
+	var b []byte
+	b, err = json.Marshal(info)
+	if err == nil {
+		sep := ",\n"
+		if s.count == 0 {
+			sep = "[\n"
+		}
+		_, err = io.WriteString(s.w, sep+string(b))
+		s.count++
+	}
+	return err
+}
+
+func (s *JSONSink) Close() (err error) {
+	if s.count == 0 {
+		_, err = io.WriteString(s.w, "[]\n")
+	} else {
+		_, err = io.WriteString(s.w, "\n]\n")
+	}
+	return err
+}
+
+// -- CSV
+
+// Write CSV with a header line
+//
+// Columns are the exported fields of the first info struct, named by json tag if available,
+// followed by `Matched` and `MatchedStr`. Embedded structs are flattened. Fields with json tag "-" are skipped.
+// Non-scalar values are written as JSON.
+type CSVSink struct {
+	w      *csv.Writer
+	typ    reflect.Type
+	fields []csvField
+}
+
+type csvField struct {
+	name  string
+	index []int
+}
+
+func NewCSVSink(w io.Writer) *CSVSink { return &CSVSink{w: csv.NewWriter(w)} }
+
+// Return column names of [CSVSink] for info struct type of [info]
+func CSVHeader(info IInfo) (header []string) {
+	for _, f := range csvFields(reflect.TypeOf(info)) {
+		header = append(header, f.name)
+	}
+	return append(header, "Matched", "MatchedStr")
+}
+
+// A nil info, typed or not, is written as a record of empty columns.
+func (s *CSVSink) Write(info IInfo) (err error) {
+	v := reflect.ValueOf(info)
+	if !v.IsValid() {
+		if s.typ == nil {
+			return errors.New("CSVSink: nil info before first info")
+		}
+		return s.w.Write(make([]string, len(s.fields)+2))
+	}
+	if s.typ == nil {
+		s.typ = v.Type()
+		s.fields = csvFields(s.typ)
+		err = s.w.Write(CSVHeader(info))
+	} else if v.Type() != s.typ {
+		err = errors.New("CSVSink: info type " + v.Type().String() + " != " + s.typ.String())
+	}
+	if err == nil {
+		for v.Kind() == reflect.Pointer && !v.IsNil() {
+			v = v.Elem()
+		}
+		record := make([]string, 0, len(s.fields)+2)
+		for _, f := range s.fields {
+			record = append(record, csvValue(v, f.index))
+		}
+		if v.Kind() == reflect.Pointer {
+			record = append(record, "", "") // typed nil
+		} else {
+			record = append(record, strconv.FormatBool(info.Matched()), info.MatchedStr())
+		}
+		err = s.w.Write(record)
+	}
+	return err
+}
+
+func (s *CSVSink) Close() error {
+	s.w.Flush()
+	return s.w.Error()
+}
+
+// Exported fields of struct [typ], flattening embedded structs
+func csvFields(typ reflect.Type) (fields []csvField) {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return nil
+	}
+	for _, sf := range reflect.VisibleFields(typ) {
+		if !sf.IsExported() || sf.Anonymous || isInfoBaseField(typ, sf) {
+			continue
+		}
+		name := sf.Name
+		if tag, ok := sf.Tag.Lookup("json"); ok {
+			tagName, _, _ := strings.Cut(tag, ",")
+			if tagName == "-" {
+				continue
+			}
+			if tagName != "" {
+				name = tagName
+			}
+		}
+		fields = append(fields, csvField{name: name, index: sf.Index})
+	}
+	return fields
+}
+
+// Return true if [sf] is promoted from [InfoBase]. Match state has its own columns.
+func isInfoBaseField(typ reflect.Type, sf reflect.StructField) bool {
+	if len(sf.Index) < 2 {
+		return false
+	}
+	parent := typ.FieldByIndex(sf.Index[:len(sf.Index)-1]).Type
+	for parent.Kind() == reflect.Pointer {
+		parent = parent.Elem()
+	}
+	return parent == reflect.TypeFor[InfoBase]()
+}
+
+// String value of field [index] of struct [v]
+func csvValue(v reflect.Value, index []int) string {
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	f, err := v.FieldByIndexErr(index)
+	if err != nil {
+		return "" // nil embedded pointer
+	}
+	for f.Kind() == reflect.Pointer || f.Kind() == reflect.Interface {
+		if f.IsNil() {
+			return ""
+		}
+		f = f.Elem()
+	}
+	switch f.Kind() {
+	case reflect.String:
+		return f.String()
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return fmt.Sprint(f.Interface())
+	}
+	if (f.Kind() == reflect.Slice || f.Kind() == reflect.Map) && f.IsNil() {
+		return ""
+	}
+	if t, ok := f.Interface().(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+	b, _ := json.Marshal(f.Interface())
+	return string(b)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package is_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/J-Siu/go-is/v3/is"
+)
+
+type sinkMeta struct {
+	Source string `json:"source"`
+	Rank   int
+}
+
+type sinkInfo struct {
+	is.InfoBase
+	sinkMeta
+	User   string            `json:"user"`
+	Secret string            `json:"-"`
+	Tags   []string          `json:"tags"`
+	Extra  map[string]string `json:"extra"`
+	Time   time.Time
+	Next   *sinkInfo `json:"next,omitempty"`
+	hidden string
+}
+
+func (i *sinkInfo) String() string { return i.User }
+
+func sinkInfos() is.IInfoList {
+	a := &sinkInfo{sinkMeta: sinkMeta{Source: "x", Rank: 1}, User: "alice", Secret: "pw", Tags: []string{"go", "rod"},
+		Time: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), hidden: "h"}
+	a.SetMatched(true)
+	a.SetMatchedStr("vip,go")
+	b := &sinkInfo{User: "bob, jr", Extra: map[string]string{"k": "v"}}
+	return is.IInfoList{a, b}
+}
+
+func TestCSVSink(t *testing.T) {
+	var buf bytes.Buffer
+	list := sinkInfos()
+	list = append(list, (*sinkInfo)(nil))
+	if err := list.Sink(is.NewCSVSink(&buf)); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Join([]string{
+		"source,Rank,user,tags,extra,Time,next,Matched,MatchedStr",
+		`x,1,alice,"[""go"",""rod""]",,2026-01-02T03:04:05Z,,true,"vip,go"`,
+		`,0,"bob, jr",,"{""k"":""v""}",0001-01-01T00:00:00Z,,false,`,
+		",,,,,,,,", // typed nil
+		"",
+	}, "\n")
+	if got := buf.String(); got != want {
+		t.Errorf("CSV =\n%s\nwant\n%s", got, want)
+	}
+
+	if got, want := strings.Join(is.CSVHeader(new(sinkInfo)), ","), "source,Rank,user,tags,extra,Time,next,Matched,MatchedStr"; got != want {
+		t.Errorf("CSVHeader = %s, want %s", got, want)
+	}
+
+	// untyped nil after first info is an empty record, before first info an error
+	buf.Reset()
+	sink := is.NewCSVSink(&buf)
+	if err := sink.Write(nil); err == nil {
+		t.Error("Write(nil) before first info = nil error")
+	}
+	if err := sink.Write(list[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Write(nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Write(&testInfo{User: "x"}); err == nil {
+		t.Error("Write() of another info type = nil error")
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Split(strings.TrimSpace(buf.String()), "\n"); len(lines) != 3 || lines[2] != ",,,,,,,," {
+		t.Errorf("CSV lines = %q", lines)
+	}
+}
+
+func TestJSONSink(t *testing.T) {
+	var buf bytes.Buffer
+	if err := new(is.IInfoList).Sink(is.NewJSONSink(&buf)); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "[]\n" {
+		t.Errorf("empty JSON = %q, want \"[]\\n\"", got)
+	}
+
+	buf.Reset()
+	list := sinkInfos()
+	if err := list.Sink(is.NewJSONSink(&buf)); err != nil {
+		t.Fatal(err)
+	}
+	var got []map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("%v\n%s", err, buf.String())
+	}
+	if len(got) != 2 || got[0]["user"] != "alice" || got[0]["source"] != "x" || got[1]["user"] != "bob, jr" {
+		t.Errorf("JSON = %v", got)
+	}
+	if _, ok := got[0]["Secret"]; ok {
+		t.Error("json:\"-\" field written")
+	}
+	if n := strings.Count(buf.String(), "\n"); n != 4 {
+		t.Errorf("JSON lines = %d, want 4 (one item per line)", n)
+	}
+}
+
+func TestJSONLSink(t *testing.T) {
+	var buf bytes.Buffer
+	list := sinkInfos()
+	if err := list.Sink(is.NewJSONLSink(&buf)); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("JSONL lines = %q", lines)
+	}
+	for i, want := range []string{"alice", "bob, jr"} {
+		var got map[string]any
+		if err := json.Unmarshal([]byte(lines[i]), &got); err != nil {
+			t.Fatal(err)
+		}
+		if got["user"] != want {
+			t.Errorf("line %d user = %v, want %s", i, got["user"], want)
+		}
+		if _, ok := got["Secret"]; ok {
+			t.Errorf("line %d: json:\"-\" field written", i)
+		}
+	}
+}
@@ -25,7 +25,6 @@ package is
 import (
 	"github.com/J-Siu/go-helper/v2/basestruct"
 	"github.com/J-Siu/go-helper/v2/ezlog"
-	"github.com/runZeroInc/go-rod"
 )
 
 // # [State]
@@ -41,22 +40,34 @@ type State struct {
 	// --
 	Name string `json:"FuncName"` // current function/state name
 	// --
-	Elements      rod.Elements `json:"-"`             // Result of [Processor.V020_Elements()]
-	ElementsCount int          `json:"ElementsCount"` // Number of elements in current iteration
+	Elements         []IElement  `json:"-"`             // Result of [Processor.V020_Elements()]
+	ElementsCount    int         `json:"ElementsCount"` // Number of elements in current iteration
+	ElementsNew      int         `json:"ElementsNew"`   // Number of new elements processed in current iteration
+	ElementKeys      []string    `json:"-"`             // Key of each element in [Elements]. Result of [Processor.V025_ElementKeys()]
+	ElementsNewIndex []int       `json:"-"`             // Index of new elements in [Elements] to be processed
+	BatchInfos       []BatchInfo `json:"-"`             // Infos extracted by [Property.ExtractJS], aligned with [ElementsNewIndex]
 	// --
-	Element      *rod.Element `json:"Element"`      // Element being process
-	ElementIndex int          `json:"ElementIndex"` // Index of element being process
-	ElementInfo  IInfo        `json:"ElementInfo"`  // [Info] of [ElementLast]. Return from [Processor.V030_ElementInfo()]
+	Element      IElement `json:"Element"`      // Element being process
+	ElementIndex int      `json:"ElementIndex"` // Index of element being process. -1 outside element loop
+	ElementKey   string   `json:"ElementKey"`   // Key of element being process. Empty if [ElementKeys] is `nil`
+	ElementInfo  IInfo    `json:"ElementInfo"`  // [Info] of [ElementLast]. Return from [Processor.V030_ElementInfo()]
 	// --
 	ElementScrollable bool `json:"ElementScrollable"` // update by V080_ElementScrollable
 	// --
-	ScrollableElement      *rod.Element `json:"ScrollableElement"`      // Last scrollable element
-	ScrollableElementIndex int          `json:"ScrollableElementIndex"` // Index of element being process
-	ScrollableElementInfo  IInfo        `json:"ScrollableElementInfo"`  // [Info] of [ElementScrollable].
+	ScrollableElement      IElement `json:"ScrollableElement"`      // Last scrollable element
+	ScrollableElementIndex int      `json:"ScrollableElementIndex"` // Index of element being process
+	ScrollableElementInfo  IInfo    `json:"ScrollableElementInfo"`  // [Info] of [ElementScrollable].
 	// --
 	Scroll      bool `json:"Scroll"`      // True = to scroll. False = don't scroll.
 	ScrollCount int  `json:"ScrollCount"` // Total number of times [Processor.ElementScroll()] called
 	ScrollPage  bool `json:"ScrollPage"`  // update by ScrollLoop
+	// --
+	AdvanceElement IElement `json:"-"`          // Button/link found by [Processor.Advance]
+	AdvanceEnd     bool     `json:"AdvanceEnd"` // True if [Processor.Advance] found no button/link
+	Navigated      bool     `json:"Navigated"`  // True if [Processor.Advance] loaded a new page
+	// --
+	ScrollHeight      int  `json:"ScrollHeight"`      // Scroll height of [Processor.Container], or document, after scrolling. Only set if [IStopHeight] is in use
+	ScrollHeightGrown bool `json:"ScrollHeightGrown"` // True if [ScrollHeight] increased since previous iteration
 }
 
 func (t *State) New(scrollCount int) *State {
@@ -67,6 +78,7 @@ func (t *State) New(scrollCount int) *State {
 	t.Scroll = true // 'Scroll' need to be init, as the default value is 'false'
 	t.ScrollPage = true
 	t.ScrollCount = scrollCount
+	t.ElementIndex = -1 // not in element loop
 	t.ScrollableElementInfo = nil
 	if t.Logger != nil {
 		t.Logger.Debug().M(prefix).Out()
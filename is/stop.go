@@ -0,0 +1,243 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package is
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Interface for scroll loop stop condition
+//
+// Used by [Processor.ScrollLoop] via [Property.StopCond]. Combine with [StopAny] and [StopAll].
+type IStop interface {
+	Reset()                                   // Called at the beginning of [Processor.Run]
+	Stop(t *Processor) (stop bool, err error) // Called at the end of each scroll loop iteration. Return true to stop.
+	String() string                           // Description for logging
+}
+
+// Optional interface of [IStop] using [State.ScrollHeight] or [State.ScrollHeightGrown]
+//
+// Scroll height is measured in each scroll loop iteration only if [Property.StopCond] implements it and return true.
+type IStopHeight interface {
+	NeedScrollHeight() bool
+}
+
+// -- Built-in stop conditions
+
+// Stop after [N] consecutive scroll iterations with no new element
+type StopNoNewElements struct {
+	N     int
+	count int
+}
+
+func (s *StopNoNewElements) Reset() { s.count = 0 }
+func (s *StopNoNewElements) Stop(t *Processor) (bool, error) {
+	if t.StateCurr.ElementsNew == 0 {
+		s.count++
+	} else {
+		s.count = 0
+	}
+	return s.count >= s.N, nil
+}
+func (s *StopNoNewElements) String() string { return "NoNewElements(" + strconv.Itoa(s.N) + ")" }
+
+// Stop after [N] consecutive scroll iterations with scroll height of [Property.Container], or document, unchanged
+type StopHeightUnchanged struct {
+	N     int
+	count int
+}
+
+func (s *StopHeightUnchanged) Reset()                 { s.count = 0 }
+func (s *StopHeightUnchanged) NeedScrollHeight() bool { return true }
+func (s *StopHeightUnchanged) Stop(t *Processor) (bool, error) {
+	if t.StateCurr.ScrollHeightGrown {
+		s.count = 0
+	} else {
+		s.count++
+	}
+	return s.count >= s.N, nil
+}
+func (s *StopHeightUnchanged) String() string { return "HeightUnchanged(" + strconv.Itoa(s.N) + ")" }
+
+// Stop when an element matching css [Selector] exists in page. eg. an end-of-feed "You're all caught up" message
+type StopSelector struct {
+	Selector string
+}
+
+func (s *StopSelector) Reset() {}
+func (s *StopSelector) Stop(t *Processor) (bool, error) {
+	found, _, err := t.Page.Has(s.Selector)
+	return found, err
+}
+func (s *StopSelector) String() string { return "Selector(" + s.Selector + ")" }
+
+// Stop when number of info collected reach [N]
+type StopMaxItems struct {
+	N int
+}
+
+func (s *StopMaxItems) Reset() {}
+func (s *StopMaxItems) Stop(t *Processor) (bool, error) {
+	return t.Report != nil && t.Report.Infos >= s.N, nil
+}
+func (s *StopMaxItems) String() string { return "MaxItems(" + strconv.Itoa(s.N) + ")" }
+
+// Stop when number of matched info reach [N]
+type StopMaxMatched struct {
+	N int
+}
+
+func (s *StopMaxMatched) Reset() {}
+func (s *StopMaxMatched) Stop(t *Processor) (bool, error) {
+	return t.Report != nil && t.Report.Matched >= s.N, nil
+}
+func (s *StopMaxMatched) String() string { return "MaxMatched(" + strconv.Itoa(s.N) + ")" }
+
+// Stop when run time reach [D]
+type StopMaxDuration struct {
+	D     time.Duration
+	start time.Time
+}
+
+func (s *StopMaxDuration) Reset() { s.start = time.Now() }
+func (s *StopMaxDuration) Stop(t *Processor) (bool, error) {
+	return time.Since(s.start) >= s.D, nil
+}
+func (s *StopMaxDuration) String() string { return "MaxDuration(" + s.D.String() + ")" }
+
+// -- Combinations
+
+// Stop when any condition is met (OR)
+//
+// All conditions are evaluated in every iteration, so stateful conditions stay up to date.
+func StopAny(conds ...IStop) IStop { return &stopGroup{conds: conds, all: false} }
+
+// Stop when all conditions are met (AND)
+//
+// All conditions are evaluated in every iteration, so stateful conditions stay up to date.
+func StopAll(conds ...IStop) IStop { return &stopGroup{conds: conds, all: true} }
+
+type stopGroup struct {
+	conds []IStop
+	all   bool
+}
+
+func (s *stopGroup) Reset() {
+	for _, c := range s.conds {
+		c.Reset()
+	}
+}
+
+func (s *stopGroup) NeedScrollHeight() bool {
+	for _, c := range s.conds {
+		if h, ok := c.(IStopHeight); ok && h.NeedScrollHeight() {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *stopGroup) Stop(t *Processor) (stop bool, err error) {
+	if len(s.conds) == 0 {
+		return false, nil
+	}
+	stop = s.all
+	for _, c := range s.conds {
+		cStop, cErr := c.Stop(t)
+		if cErr != nil && err == nil {
+			err = cErr
+		}
+		if s.all {
+			stop = stop && cStop
+		} else {
+			stop = stop || cStop
+		}
+	}
+	return stop, err
+}
+
+func (s *stopGroup) String() string {
+	var (
+		op   = " OR "
+		strs []string
+	)
+	if s.all {
+		op = " AND "
+	}
+	for _, c := range s.conds {
+		strs = append(strs, c.String())
+	}
+	return "(" + strings.Join(strs, op) + ")"
+}
+
+// -- Serializable selector
+
+// Serializable description of a stop condition, for [Property.Stop]
+//
+// [Kind] is one of "NoNewElements", "HeightUnchanged", "KnownInRow", "MaxItems", "MaxMatched" (using [N]),
+// "Selector" (using [Selector]), "MaxDuration" (using [D]), or "Any", "All" (using [Conds]).
+// eg. `{"Kind": "Any", "Conds": [{"Kind": "NoNewElements", "N": 3}, {"Kind": "MaxItems", "N": 100}]}`
+type StopSpec struct {
+	Kind     string        `json:"Kind"`
+	N        int           `json:"N,omitempty"`
+	Selector string        `json:"Selector,omitempty"`
+	D        time.Duration `json:"D,omitempty"` // Nanoseconds in JSON
+	Conds    []StopSpec    `json:"Conds,omitempty"`
+}
+
+// Return a new [IStop] described by [s]. Each call returns new condition(s) with their own state.
+func (s *StopSpec) Build() (IStop, error) {
+	switch s.Kind {
+	case "NoNewElements":
+		return &StopNoNewElements{N: s.N}, nil
+	case "HeightUnchanged":
+		return &StopHeightUnchanged{N: s.N}, nil
+	case "KnownInRow":
+		return &StopKnownInRow{N: s.N}, nil
+	case "MaxItems":
+		return &StopMaxItems{N: s.N}, nil
+	case "MaxMatched":
+		return &StopMaxMatched{N: s.N}, nil
+	case "Selector":
+		return &StopSelector{Selector: s.Selector}, nil
+	case "MaxDuration":
+		return &StopMaxDuration{D: s.D}, nil
+	case "Any", "All":
+		conds := make([]IStop, 0, len(s.Conds))
+		for i := range s.Conds {
+			c, err := s.Conds[i].Build()
+			if err != nil {
+				return nil, err
+			}
+			conds = append(conds, c)
+		}
+		if s.Kind == "All" {
+			return StopAll(conds...), nil
+		}
+		return StopAny(conds...), nil
+	}
+	return nil, fmt.Errorf("StopSpec: unknown kind %q", s.Kind)
+}
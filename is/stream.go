@@ -0,0 +1,90 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package is
+
+import (
+	"context"
+	"iter"
+)
+
+// Run the processor in a goroutine, sending each info on a channel as it is extracted
+//
+//   - [size] is the channel buffer size. When the buffer is full, the scroll loop pauses until the consumer catches up.
+//   - info channel is closed when the run ends. [Err] is then sent on the error channel, `nil` if no error.
+//   - infos are sent in processing order, after [V070_ElementProcess], and also added to [IInfoList] if it is not nil.
+//
+// The processor must not be used until the info channel is closed.
+func (t *Processor) StreamChan(ctx context.Context, size int) (<-chan IInfo, <-chan error) {
+	var (
+		infos = make(chan IInfo, size)
+		errs  = make(chan error, 1)
+	)
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	go func() {
+		defer close(errs)
+		defer close(infos)
+		t.emit = func(info IInfo) {
+			select {
+			case infos <- info:
+			case <-ctx.Done():
+			}
+		}
+		defer func() { t.emit = nil }()
+		t.RunContext(ctx)
+		errs <- t.Err
+	}()
+	return infos, errs
+}
+
+// Run the processor and yield each info as it is extracted
+//
+// The scroll loop pauses while the consumer is busy. Breaking out of the loop cancels the run.
+// If the run fails, the last pair yielded is (nil, [Err]).
+//
+//	for info, err := range p.Stream(ctx) {
+//		if err != nil { ... }
+//	}
+func (t *Processor) Stream(ctx context.Context) iter.Seq2[IInfo, error] {
+	return func(yield func(IInfo, error) bool) {
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		infos, errs := t.StreamChan(ctx, 0)
+		for info := range infos {
+			if !yield(info, nil) {
+				cancel()
+				// wait for run to end
+				for range infos {
+				}
+				return
+			}
+		}
+		if err := <-errs; err != nil {
+			yield(nil, err)
+		}
+	}
+}
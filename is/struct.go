@@ -24,11 +24,15 @@ THE SOFTWARE.
 package is
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
 
 	"github.com/J-Siu/go-helper/v2/basestruct"
 	"github.com/J-Siu/go-helper/v2/ezlog"
-	"github.com/runZeroInc/go-rod"
 )
 
 type ProcessorFunc func()
@@ -40,9 +44,35 @@ type Processor struct {
 
 	Logger *ezlog.EzLog
 
+	// Context of current [RunContext]. [Page] and [Container] are bound to it during the run.
+	//
+	// Field functions should check it for long running operations.
+	Ctx context.Context `json:"-"`
+
 	StateCurr *State
 	StatePrev *State
 
+	Seen  map[string]bool `json:"-"` // Keys of processed elements/infos. Kept across runs. Set to `nil` to reset.
+	Known map[string]bool `json:"-"` // Keys seen by previous runs, loaded from [WatermarkFile]. Known items are not processed.
+
+	ErrJournal []ErrRecord `json:"ErrJournal,omitempty"` // Failed stages of current/last run
+	Report     *RunReport  `json:"Report,omitempty"`     // Summary of current/last run
+
+	emit         func(IInfo) // Send info to [StreamChan]
+	lastKey      string      // Key of last processed element, for [Checkpoint]
+	resumeKey    string      // Checkpoint [LastKey] not yet found while resuming
+	resumeMax    int         // Fast-forward stops at this [State.ScrollCount] even if [resumeKey] is not found
+	resumeFound  bool        // [resumeKey] found in current iteration, which is still fast-forwarded
+	watermark    *Watermark  // Loaded from [WatermarkFile]
+	knownInRow   int         // Number of consecutive known items
+	runKeys      []string    // Keys of items extracted in this run, in order
+	runTime      time.Time   // Newest [IInfoTime] of this run
+	recording    *Recording  // Saved to [RecordFile]
+	sinkNext     int         // Index of next sink to write current info to. Sinks before it succeeded
+	batchIndex   map[int]int // Element index -> index in [State.BatchInfos], built once per iteration
+	ctxPage      IPage       // [Page] before [bindCtx]
+	ctxContainer IElement    // [Container] before [bindCtx]
+
 	// -- Following 4 field func rarely need override
 
 	// Load [UrlStr] into [Page]
@@ -55,10 +85,27 @@ type Processor struct {
 	// No override needed.
 	ScrollLoop func() `json:"-"`
 
-	// Use [MustScrollIntoView] on [element]
+	// Scroll the page base on [Property.ScrollMode]. Default use [ScrollIntoView] on [element].
+	//
+	// [element] is [StatePrev.ScrollableElement]. Nothing is done if it is `nil`.
 	//
 	// No override needed.
-	ScrollElement func(element *rod.Element) `json:"-"`
+	ScrollElement func(element IElement) `json:"-"`
+
+	// Click "Show more" button or follow "Next" link base on [Property.AdvanceMode].
+	// Used instead of [ScrollElement] if [Property.AdvanceMode] is not [AdvanceScroll].
+	//
+	// Set [StateCurr.AdvanceEnd] if button/link is not found. Set [StateCurr.Navigated] if a new page is loaded.
+	//
+	// No override needed.
+	Advance ProcessorFunc `json:"-"`
+
+	// Decide the action when a stage fails. See [ErrPolicy].
+	//
+	// build-in behavior is to return [Property.ErrPolicy]
+	//
+	// Override if needed. eg. retry only on [StageError.Panic]
+	ErrorPolicy func(err *StageError) ErrPolicy `json:"-"`
 
 	// --- Overload following field func as needed
 
@@ -76,8 +123,23 @@ type Processor struct {
 	// **Must override**
 	V020_Elements ProcessorFunc `json:"-"`
 
+	// Put key of each element of [StateCurr.Elements] into [StateCurr.ElementKeys]
+	//
+	// Elements with a key in [Seen] are not processed again, regardless of their position in [StateCurr.Elements].
+	// Elements with an empty key are treated as detached from DOM and skipped.
+	// If [StateCurr.ElementKeys] is `nil`, elements after [StatePrev.ElementsCount] are processed,
+	// or all elements if [Property.Virtualized].
+	//
+	// build-in behavior is a DOM fingerprint of each element, using one JS call.
+	// It uses id, `data-*id` attribute or permalink href, and falls back to links and text
+	//
+	// Override if needed. eg. use a post id attribute
+	V025_ElementKeys ProcessorFunc `json:"-"`
+
 	// Extract information from [element] and put into an [IInfo] structure and return it.
 	//
+	// Not called if [Property.ExtractJS] is set, as infos are extracted in batch.
+	//
 	// build-in behavior is to return `nil`
 	//
 	// **Must override**
@@ -148,11 +210,20 @@ func (t *Processor) New(property *Property) *Processor {
 		t.Err = errors.New(prefix + ": property cannot be nil")
 	} else if property.Page == nil {
 		t.Err = errors.New(prefix + ": page/tab cannot be nil")
+	} else if property.ScrollMode == ScrollModePixels && property.ScrollPixels <= 0 {
+		t.Err = errors.New(prefix + ": ScrollPixels must be > 0 in ScrollModePixels")
 	} else {
 		t.Property = *property
-		t.StateCurr = new(State).New(0)
-		t.setFunc()
-		t.Initialized = true
+		if t.StopCond == nil && t.Stop != nil {
+			t.StopCond, t.Err = t.Stop.Build()
+		}
+		if t.Err != nil {
+			t.Err = fmt.Errorf("%s: %w", prefix, t.Err)
+		} else {
+			t.StateCurr = new(State).New(0)
+			t.setFunc()
+			t.Initialized = true
+		}
 	}
 
 	if t.Logger != nil {
@@ -161,45 +232,168 @@ func (t *Processor) New(property *Property) *Processor {
 	return t
 }
 
-func (t *Processor) funcWrapper(name string, f ProcessorFunc) *Processor {
-	if t.Logger != nil {
-		t.Logger.Debug().N(name).TxtStart().Out()
+// Run stage [f] with panic recovery
+//
+//   - A panic, or [Err] set by [f], is converted into a [StageError]
+//   - [ErrorPolicy] decides whether to retry, skip or abort. Context errors always abort.
+//
+// Returns false if the stage failed. [Err] is set if the run should abort.
+func (t *Processor) funcWrapper(stage string, f ProcessorFunc) (ok bool) {
+	name := t.MyType + "." + stage
+	for attempt := 1; ; attempt++ {
+		if t.Logger != nil {
+			t.Logger.Debug().N(name).TxtStart().Out()
+		}
+		stageErr := t.funcRun(stage, attempt, f)
+		if t.Logger != nil {
+			t.Logger.Debug().N(t.StateCurr.Name).TxtEnd().Out()
+		}
+		if stageErr == nil {
+			return true
+		}
+		policy := ErrPolicyAbort
+		if !isCtxErr(stageErr) {
+			policy = t.ErrorPolicy(stageErr)
+		}
+		if t.Logger != nil {
+			t.Logger.Err().N(name).N(policy.String()).M(stageErr).Out()
+		}
+		rec := ErrRecord{StageError: stageErr, Policy: policy}
+		if stageErr.ElementIndex >= 0 {
+			rec.Info = t.StateCurr.ElementInfo
+		}
+		t.journal(rec)
+		switch policy {
+		case ErrPolicyRetry:
+			if attempt <= t.ErrRetryMax && t.checkCtx(name) {
+				continue
+			}
+		case ErrPolicySkip:
+		default:
+			t.Err = stageErr
+		}
+		return false
 	}
+}
+
+// Run [f] once, return [StageError] if it panics or sets [Err]
+func (t *Processor) funcRun(stage string, attempt int, f ProcessorFunc) (stageErr *StageError) {
+	errPrev := t.Err
+	defer func() {
+		var err error
+		if r := recover(); r != nil {
+			err = panicErr(r)
+			stageErr = &StageError{Panic: r}
+		} else if errPrev == nil && t.Err != nil {
+			err = t.Err
+			stageErr = new(StageError)
+		}
+		if stageErr != nil {
+			t.Err = errPrev
+			stageErr.Stage = stage
+			stageErr.ElementIndex = t.StateCurr.ElementIndex
+			stageErr.ScrollCount = t.StateCurr.ScrollCount
+			stageErr.Attempt = attempt
+			stageErr.Err = err
+		}
+	}()
 	f()
-	if t.Logger != nil {
-		t.Logger.Debug().N(t.StateCurr.Name).TxtEnd().Out()
-	}
-	return t
+	return nil
 }
 
 // Process the page
 //
+// Same as [RunContext] with [context.Background].
+//
+// No override needed.
+func (t *Processor) Run() *RunReport { return t.RunContext(context.Background()) }
+
+// Process the page with [ctx]
+//
+//   - [Page] and [Container] are bound to [ctx] for the duration of the run, and restored afterward
+//   - [ctx] is available to all field functions as [Processor.Ctx]
+//   - scroll loop and element loop stop when [ctx] is canceled or its deadline is exceeded, and [ctx.Err()] is recorded in [Err]
+//
+// Returns [Report], summary of the run. Failed stages are recorded in [ErrJournal].
+//
 // No override needed.
-func (t *Processor) Run() {
+func (t *Processor) RunContext(ctx context.Context) *RunReport {
 	prefix := t.MyType + ".Run" + "(base)"
 	if t.Logger != nil {
 		t.Logger.Debug().N(prefix).TxtStart().Out()
 	}
+	t.ErrJournal = nil
+	if t.Seen == nil {
+		t.Seen = make(map[string]bool)
+	}
+	t.Report = new(RunReport).New()
+	defer t.reportEnd()
+	if t.StopCond != nil {
+		t.StopCond.Reset()
+	}
 	if t.CheckErrInit(prefix) {
-		t.funcWrapper(t.MyType+".LoadPage", t.LoadPage)
+		t.bindCtx(ctx)
+		defer t.unbindCtx()
+		t.lastKey, t.resumeKey, t.resumeMax, t.resumeFound = "", "", 0, false
+		t.watermark, t.knownInRow, t.runKeys, t.runTime = nil, 0, nil, time.Time{}
+		t.recording = nil
+		if t.RecordFile != "" {
+			t.recording = &Recording{Time: time.Now(), UrlStr: t.UrlStr}
+		}
+		if t.WatermarkFile != "" {
+			t.funcWrapper("WatermarkLoad", t.watermarkLoad)
+		}
+		if t.Err == nil && t.CheckpointFile != "" && t.CheckpointResume {
+			t.funcWrapper("Resume", t.resume)
+		}
+		if t.Err == nil && t.checkCtx(prefix) {
+			t.funcWrapper("LoadPage", t.LoadPage)
+		}
 	}
 	if t.Err == nil {
 		// Initial container
-		t.funcWrapper(t.MyType+".V010", t.V010_Container)
+		t.funcWrapper("V010", t.V010_Container)
+	}
+	if t.Err == nil {
 		// Scroll Loop
-		for t.StateCurr.ScrollPage {
+		for t.StateCurr.ScrollPage && t.checkCtx(prefix) {
 			if t.Logger != nil {
 				t.Logger.Debug().N(prefix).N("SCROLL LOOP").TxtStart().Out()
 			}
 			// -- SCROLL LOOP - START
 			t.StatePrev = t.StateCurr
-			if t.StatePrev != nil {
-				t.ScrollElement(t.StatePrev.ScrollableElement)
+			if t.StatePrev.ScrollableElement != nil && t.AdvanceMode != AdvanceScroll {
+				t.funcWrapper("Advance", t.Advance)
+			} else {
+				t.funcWrapper("ScrollElement", func() { t.ScrollElement(t.StatePrev.ScrollableElement) })
+			}
+			if t.Err != nil || !t.checkCtx(prefix) {
+				break
 			}
 			t.StateCurr = new(State).New(t.StatePrev.ScrollCount)
+			t.StateCurr.AdvanceEnd = t.StatePrev.AdvanceEnd
+			if t.StatePrev.Navigated {
+				// new page, new container
+				t.funcWrapper("V010", t.V010_Container)
+				if t.Err != nil {
+					break
+				}
+			}
+			if t.needScrollHeight() {
+				t.funcWrapper("ScrollHeight", t.scrollHeight)
+				if t.Err != nil {
+					break
+				}
+			}
 			// -- Get elements
 			t.StateCurr.ElementsCount = 0
-			t.funcWrapper(t.MyType+".V020", t.V020_Elements)
+			t.funcWrapper("V020", t.V020_Elements)
+			if t.Err == nil && t.recording != nil {
+				t.funcWrapper("Record", t.record)
+			}
+			if t.Err != nil {
+				break
+			}
 
 			if t.StateCurr.Elements == nil {
 				t.StateCurr.Scroll = false // no element, no scroll
@@ -208,43 +402,107 @@ func (t *Processor) Run() {
 				if t.Logger != nil {
 					t.Logger.Trace().N(prefix).N("elements count").M(t.StateCurr.ElementsCount).Out()
 				}
-				for index := t.StatePrev.ElementsCount; index < t.StateCurr.ElementsCount; index++ {
+				t.funcWrapper("V025", t.V025_ElementKeys)
+				keys := t.StateCurr.ElementKeys
+				if t.Err == nil && keys != nil && len(keys) != t.StateCurr.ElementsCount {
+					t.Err = errors.New(prefix + ": keys count " + strconv.Itoa(len(keys)) + " != elements count " + strconv.Itoa(t.StateCurr.ElementsCount))
+				}
+				if t.Err != nil {
+					break
+				}
+				if t.resumeKey != "" && slices.Contains(keys, t.resumeKey) {
 					if t.Logger != nil {
-						t.Logger.Debug().N(prefix).N("ELEMENTS LOOP").TxtStart().Out()
+						t.Logger.Debug().N(prefix).N("resume point found").M(t.resumeKey).Out()
 					}
-					// -- ELEMENTS LOOP - START
-					t.StateCurr.Element = (t.StateCurr.Elements)[index]
-					t.StateCurr.ElementIndex = index
-					t.StateCurr.ElementInfo = nil
-					t.funcWrapper(t.MyType+".V030", t.V030_ElementInfo)
-					if t.StateCurr.ElementInfo != nil {
-						t.funcWrapper(t.MyType+".V040", t.V040_ElementMatch)
-						if t.StateCurr.ElementInfo.Matched() {
-							t.funcWrapper(t.MyType+".V050", t.V050_ElementProcessMatched)
-						} else {
-							t.funcWrapper(t.MyType+".V060", t.V060_ElementProcessUnmatch)
+					t.resumeKey, t.resumeFound = "", true
+				}
+				index, indexEnd := t.StatePrev.ElementsCount, t.StateCurr.ElementsCount
+				if keys != nil || t.Virtualized {
+					index = 0
+				} else if t.Direction == ScrollUp {
+					// new elements are prepended
+					index, indexEnd = 0, t.StateCurr.ElementsCount-t.StatePrev.ElementsCount
+				}
+				// -- New elements. Known elements are kept in [order], so known-in-row is counted in DOM order.
+				var order []int
+				batch := make(map[string]bool)
+				for ; index < indexEnd; index++ {
+					if keys != nil {
+						if keys[index] == "" {
+							t.Report.Detached++
+							continue
+						}
+						if t.Seen[keys[index]] || batch[keys[index]] {
+							continue
 						}
+						batch[keys[index]] = true
+					}
+					order = append(order, index)
+					if keys == nil || !t.Known[keys[index]] {
+						t.StateCurr.ElementsNewIndex = append(t.StateCurr.ElementsNewIndex, index)
 					}
-					t.funcWrapper(t.MyType+".V070", t.V070_ElementProcess)
-					// info list
-					if t.IInfoList != nil && t.StateCurr.ElementInfo != nil {
-						*t.IInfoList = append(*t.IInfoList, t.StateCurr.ElementInfo)
+				}
+				if t.ExtractJS != "" && len(t.StateCurr.ElementsNewIndex) > 0 {
+					t.funcWrapper("V030Batch", t.batchInfo)
+					if t.Err != nil {
+						break
+					}
+				}
+				infoCount := t.infoCount()
+				for _, index := range order {
+					if t.Err != nil || !t.checkCtx(prefix) {
+						break
+					}
+					if keys != nil && t.Known[keys[index]] {
+						t.knownItem()
+						t.see(keys[index])
+						continue
+					}
+					if keys != nil {
+						t.StateCurr.ElementKey = keys[index]
+					}
+					if t.Logger != nil {
+						t.Logger.Debug().N(prefix).N("ELEMENTS LOOP").TxtStart().Out()
 					}
-					t.funcWrapper(t.MyType+".V080", t.V080_ElementScrollable)
-					if t.StateCurr.Scroll {
-						t.StateCurr.ScrollableElement = t.StateCurr.Element
-						t.StateCurr.ScrollableElementIndex = t.StateCurr.ElementIndex
-						t.StateCurr.ScrollableElementInfo = t.StateCurr.ElementInfo
+					t.processElement(index)
+					if keys != nil && t.Err == nil {
+						// an element aborting the run is not seen, so it is processed again on resume
+						t.see(keys[index])
+						t.lastKey = keys[index]
 					}
-					t.funcWrapper(t.MyType+".V090", t.V090_ElementLoopEnd)
-					// -- ELEMENTS LOOP - END
+					t.StateCurr.ElementsNew++
 					if t.Logger != nil {
 						t.Logger.Debug().N(prefix).N("ELEMENTS LOOP").TxtEnd().Out()
 					}
 				}
+				t.StateCurr.ElementIndex = -1
+				t.StateCurr.ElementKey = ""
+				if t.Direction == ScrollUp {
+					t.infoPrepend(infoCount)
+				}
+				// No new scrollable element, keep scrolling from the last (or first if [ScrollUp]) element
+				if t.StateCurr.ScrollableElement == nil && t.StateCurr.ElementsCount > 0 {
+					t.StateCurr.ScrollableElementIndex = t.StateCurr.ElementsCount - 1
+					if t.Direction == ScrollUp {
+						t.StateCurr.ScrollableElementIndex = 0
+					}
+					t.StateCurr.ScrollableElement = t.StateCurr.Elements[t.StateCurr.ScrollableElementIndex]
+				}
+			}
+			if t.recording != nil {
+				t.recordEnd()
+			}
+			if t.Err != nil {
+				break
+			}
+			t.funcWrapper("V100", t.V100_ScrollLoopEnd)
+			t.funcWrapper("ScrollLoop", t.ScrollLoop)
+			if t.CheckpointFile != "" && t.CheckpointEvery > 0 && (t.StateCurr.ScrollCount+1)%t.CheckpointEvery == 0 {
+				t.funcWrapper("Checkpoint", t.checkpoint)
+			}
+			if t.Err != nil || !t.checkCtx(prefix) {
+				t.StateCurr.ScrollPage = false
 			}
-			t.funcWrapper(t.MyType+".V100", t.V100_ScrollLoopEnd)
-			t.funcWrapper(t.MyType+".ScrollLoop", t.ScrollLoop)
 			t.StateCurr.ScrollCount++
 			// -- SCROLL LOOP - END
 			if t.Logger != nil {
@@ -252,6 +510,200 @@ func (t *Processor) Run() {
 			}
 		}
 	}
+	// Watermark, only on clean completion, so items of a failed run are processed again
+	if t.watermark != nil && t.Err == nil {
+		if err := t.watermarkSave(); err != nil && t.Err == nil {
+			t.Err = err
+		}
+	}
+	// Recording, also on error and cancellation
+	if t.recording != nil {
+		if err := t.recordSave(); err != nil && t.Err == nil {
+			t.Err = err
+		}
+	}
+	// Final checkpoint on error and cancellation. Removed on clean completion, so next run does not resume.
+	if t.CheckpointFile != "" && t.Initialized {
+		if t.Err == nil {
+			t.checkpointRemove()
+		} else if t.Report.ElementsSeen > 0 {
+			errPrev := t.Err
+			t.checkpoint()
+			t.Err = errPrev
+		}
+	}
+	return t.Report
+}
+
+// Write [StateCurr.ElementInfo] to [Sinks]
+//
+// Start from [sinkNext], so a retry with [ErrPolicyRetry] does not write again to sinks that succeeded.
+func (t *Processor) sink() {
+	prefix := t.MyType + ".Sink"
+	t.StateCurr.Name = prefix
+	for ; t.sinkNext < len(t.Sinks); t.sinkNext++ {
+		if err := t.Sinks[t.sinkNext].Write(t.StateCurr.ElementInfo); err != nil {
+			t.Err = fmt.Errorf("%s: %w", prefix, err)
+			break
+		}
+	}
+}
+
+// Return length of [IInfoList], 0 if it is nil
+func (t *Processor) infoCount() int {
+	if t.IInfoList == nil {
+		return 0
+	}
+	return len(*t.IInfoList)
+}
+
+// Move infos appended after [from] to the front of [IInfoList], keeping their order
+//
+// Used by [ScrollUp], as elements of later iterations are above those of earlier ones.
+func (t *Processor) infoPrepend(from int) {
+	if t.IInfoList != nil && from > 0 && from < len(*t.IInfoList) {
+		list := *t.IInfoList
+		*t.IInfoList = append(append(IInfoList{}, list[from:]...), list[:from]...)
+	}
+}
+
+// Finalize [Report] at the end of run
+func (t *Processor) reportEnd() {
+	t.Report.TimeEnd = time.Now()
+	t.Report.Err = t.Err
+	if t.StateCurr != nil {
+		t.Report.ScrollCount = t.StateCurr.ScrollCount
+	}
+}
+
+// Run element stages V030 - V090 on element at [index] of [StateCurr.Elements]
+//
+// Remaining stages are skipped if a stage fails.
+func (t *Processor) processElement(index int) {
+	// -- ELEMENTS LOOP - START
+	t.StateCurr.Element = (t.StateCurr.Elements)[index]
+	t.StateCurr.ElementIndex = index
+	t.StateCurr.ElementInfo = nil
+	t.Report.ElementsSeen++
+	v030 := t.V030_ElementInfo
+	if t.StateCurr.BatchInfos != nil {
+		v030 = t.batchElementInfo
+	}
+	if !t.funcWrapper("V030", v030) {
+		return
+	}
+	if t.StateCurr.ElementKey != "" {
+		t.remember(t.StateCurr.ElementKey)
+	}
+	if info, ok := t.StateCurr.ElementInfo.(IInfoKey); ok && info.Key() != "" {
+		key := "info:" + info.Key()
+		if t.Seen[key] {
+			t.Report.Duplicates++
+			if t.Logger != nil {
+				t.Logger.Debug().N("duplicate").M(key).Out()
+			}
+			return
+		}
+		if t.Known[key] {
+			t.knownItem()
+			t.see(key)
+			return
+		}
+		t.see(key)
+		t.remember(key)
+	}
+	if t.StateCurr.ElementInfo != nil && t.knownTime(t.StateCurr.ElementInfo) {
+		t.knownItem()
+		return
+	}
+	if t.StateCurr.ElementInfo != nil {
+		t.knownInRow = 0
+		t.Report.Infos++
+		if !t.funcWrapper("V040", t.V040_ElementMatch) {
+			return
+		}
+		if t.StateCurr.ElementInfo.Matched() {
+			t.Report.Matched++
+			if !t.funcWrapper("V050", t.V050_ElementProcessMatched) {
+				return
+			}
+		} else {
+			t.Report.Unmatched++
+			if !t.funcWrapper("V060", t.V060_ElementProcessUnmatch) {
+				return
+			}
+		}
+		if !t.processLabels() {
+			return
+		}
+	}
+	if !t.funcWrapper("V070", t.V070_ElementProcess) {
+		return
+	}
+	// info list
+	if t.IInfoList != nil && t.StateCurr.ElementInfo != nil {
+		*t.IInfoList = append(*t.IInfoList, t.StateCurr.ElementInfo)
+	}
+	// stream
+	if t.emit != nil && t.StateCurr.ElementInfo != nil {
+		t.emit(t.StateCurr.ElementInfo)
+	}
+	// sinks
+	if len(t.Sinks) > 0 && t.StateCurr.ElementInfo != nil {
+		t.sinkNext = 0
+		if !t.funcWrapper("Sink", t.sink) {
+			return
+		}
+	}
+	if !t.funcWrapper("V080", t.V080_ElementScrollable) {
+		return
+	}
+	// [ScrollUp] keep the top-most element
+	if t.StateCurr.Scroll && (t.Direction != ScrollUp || t.StateCurr.ScrollableElement == nil) {
+		t.StateCurr.ScrollableElement = t.StateCurr.Element
+		t.StateCurr.ScrollableElementIndex = t.StateCurr.ElementIndex
+		t.StateCurr.ScrollableElementInfo = t.StateCurr.ElementInfo
+	}
+	t.funcWrapper("V090", t.V090_ElementLoopEnd)
+	// -- ELEMENTS LOOP - END
+}
+
+// Bind [Page] and [Container] to [ctx]
+func (t *Processor) bindCtx(ctx context.Context) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	t.Ctx = ctx
+	t.ctxPage = t.Page
+	t.ctxContainer = t.Container
+	t.Page = t.Page.Context(ctx)
+	if t.Container != nil {
+		t.Container = t.Container.Context(ctx)
+	}
+}
+
+// Restore [Page] and [Container] saved by [bindCtx]
+func (t *Processor) unbindCtx() {
+	t.Page = t.ctxPage
+	if t.ctxContainer != nil {
+		t.Container = t.ctxContainer
+	}
+	t.ctxPage = nil
+	t.ctxContainer = nil
+}
+
+// Return false and record [Ctx.Err()] in [Err] if [Ctx] is done
+func (t *Processor) checkCtx(prefix string) bool {
+	if t.Ctx != nil && t.Ctx.Err() != nil {
+		if t.Err == nil {
+			t.Err = fmt.Errorf("%s: %w", prefix, t.Ctx.Err())
+			if t.Logger != nil {
+				t.Logger.Err().M(t.Err).Out()
+			}
+		}
+		return false
+	}
+	return true
 }
 
 // Implement the default field functions
@@ -260,9 +712,12 @@ func (t *Processor) setFunc() {
 	t.LoadPage = t.base_LoadPage
 	t.ScrollElement = t.base_ScrollElement
 	t.ScrollLoop = t.base_ScrollLoop
+	t.Advance = t.base_Advance
+	t.ErrorPolicy = t.base_ErrorPolicy
 	// --- Overload following field func as needed
 	t.V010_Container = t.base_V010_Container
 	t.V020_Elements = t.base_V020_Elements
+	t.V025_ElementKeys = t.base_V025_ElementKeys
 	t.V030_ElementInfo = t.base_V030_ElementInfo
 	t.V040_ElementMatch = t.base_V040_ElementMatch
 	t.V050_ElementProcessMatched = t.base_V050_ElementProcessMatched
@@ -284,16 +739,16 @@ func (t *Processor) base_LoadPage() {
 			t.Err = t.Page.Navigate(t.UrlStr)
 			if t.Err == nil {
 				if t.Logger != nil {
-					t.Logger.Trace().N(prefix).N("MustWaitDOMStable").TxtStart().Out()
+					t.Logger.Trace().N(prefix).N("Wait").TxtStart().Out()
 				}
-				t.Page.MustWaitDOMStable()
+				t.Err = t.wait(t.WaitLoad)
 				if t.Logger != nil {
-					t.Logger.Trace().N(prefix).N("MustWaitDOMStable").TxtEnd().Out()
+					t.Logger.Trace().N(prefix).N("Wait").TxtEnd().Out()
 				}
 			}
 		}
 		if t.Err != nil {
-			t.Err = errors.New(prefix + ": " + t.Err.Error())
+			t.Err = fmt.Errorf("%s: %w", prefix, t.Err)
 			if t.Logger != nil {
 				t.Logger.Err().M(t.Err).Out()
 			}
@@ -301,17 +756,34 @@ func (t *Processor) base_LoadPage() {
 	}
 }
 
-func (t *Processor) base_ScrollElement(element *rod.Element) {
+func (t *Processor) base_ScrollElement(element IElement) {
 	prefix := t.MyType + ".ScrollElement" + "(base)"
 	if t.Logger != nil {
 		t.Logger.Debug().N(prefix).TxtStart().Out()
 	}
 	if element != nil {
-		element.MustScrollIntoView()
-		if t.Logger != nil {
-			t.Logger.Trace().N(prefix).M("Scrolled").Out()
+		var err error
+		switch t.scrollMode() {
+		case ScrollModeViewport, ScrollModePixels, ScrollModeBottom:
+			_, err = t.scrollContainer(t.scrollMode())
+		default:
+			if t.Ctx != nil {
+				element = element.Context(t.Ctx)
+			}
+			err = element.ScrollIntoView()
+		}
+		if err == nil {
+			if t.Logger != nil {
+				t.Logger.Trace().N(prefix).M("Scrolled").Out()
+			}
+			err = t.wait(t.WaitScroll)
+		}
+		if err != nil {
+			t.Err = fmt.Errorf("%s: %w", prefix, err)
+			if t.Logger != nil {
+				t.Logger.Err().M(t.Err).Out()
+			}
 		}
-		t.Page.MustWaitDOMStable()
 	}
 	if t.Logger != nil {
 		t.Logger.Debug().N(prefix).TxtEnd().Out()
@@ -323,7 +795,32 @@ func (t *Processor) base_ScrollLoop() {
 	t.StateCurr.Name = prefix
 	var (
 		scrollPage = t.StateCurr == nil || (t.StateCurr.Scroll && (t.StateCurr.ScrollCount < t.ScrollMax || t.ScrollMax < 0))
+		stop       bool
 	)
+	if t.StateCurr.AdvanceEnd {
+		scrollPage = false
+	}
+	if t.resumeKey != "" && t.StateCurr.ScrollCount >= t.resumeMax {
+		// checkpoint element not found in time, continue as a normal run
+		if t.Logger != nil {
+			t.Logger.Debug().N(prefix).N("resume point not found").M(t.resumeKey).Out()
+		}
+		t.resumeKey = ""
+	}
+	if (t.resumeKey != "" || t.resumeFound) && t.StateCurr.Scroll && !t.StateCurr.AdvanceEnd {
+		// fast-forward to checkpoint. The iteration finding it may have no new element, so [StopCond] is not checked yet.
+		t.resumeFound = false
+		scrollPage = true
+		if t.Logger != nil {
+			t.Logger.Debug().N(prefix).N("resuming").M(t.resumeKey).Out()
+		}
+	} else if scrollPage && t.StopCond != nil {
+		stop, t.Err = t.StopCond.Stop(t)
+		if t.Err != nil {
+			t.Err = fmt.Errorf("%s: %s: %w", prefix, t.StopCond.String(), t.Err)
+		}
+		scrollPage = !stop
+	}
 	if t.Logger != nil {
 		if t.Logger.GetLogLevel() == ezlog.DEBUG ||
 			t.Logger.GetLogLevel() == ezlog.TRACE {
@@ -333,6 +830,7 @@ func (t *Processor) base_ScrollLoop() {
 				N(prefix).
 				Ln("StateCurr").M(t.StateCurr).
 				Ln("scrollMax").M(t.ScrollMax).
+				Ln("stopCond").M(t.StopCond).N("stop").M(stop).
 				Ln("scrollLoop").N("t.StateCurr == nil || (t.StateCurr.Scroll && (t.StateCurr.ScrollCount < t.ScrollMax || t.ScrollMax < 0))").M(scrollPage).
 				Out()
 		}
@@ -340,6 +838,14 @@ func (t *Processor) base_ScrollLoop() {
 	t.StateCurr.ScrollPage = scrollPage
 }
 
+func (t *Processor) base_ErrorPolicy(err *StageError) ErrPolicy {
+	prefix := t.MyType + ".ErrorPolicy" + "(base)"
+	if t.Logger != nil {
+		t.Logger.Trace().N(prefix).N(err.Stage).M(t.ErrPolicy.String()).Out()
+	}
+	return t.ErrPolicy
+}
+
 func (t *Processor) base_V010_Container() {
 	prefix := t.MyType + ".V010_Container" + "(base)"
 	t.StateCurr.Name = prefix
@@ -356,6 +862,20 @@ func (t *Processor) base_V020_Elements() {
 	}
 }
 
+func (t *Processor) base_V025_ElementKeys() {
+	prefix := t.MyType + ".V025_ElementKeys" + "(base)"
+	t.StateCurr.Name = prefix
+	keys, err := t.Page.Keys(t.StateCurr.Elements)
+	if err == nil {
+		t.StateCurr.ElementKeys = keys
+	} else {
+		t.Err = fmt.Errorf("%s: %w", prefix, err)
+	}
+	if t.Logger != nil {
+		t.Logger.Trace().N(prefix).M(t.StateCurr.ElementKeys).Out()
+	}
+}
+
 func (t *Processor) base_V030_ElementInfo() {
 	prefix := t.MyType + ".V030_ElementInfo" + "(base)"
 	t.StateCurr.Name = prefix
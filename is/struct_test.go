@@ -0,0 +1,836 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package is_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/J-Siu/go-is/v3/is"
+	"github.com/J-Siu/go-is/v3/is/fake"
+	"github.com/J-Siu/go-is/v3/is/typed"
+)
+
+type testInfo struct {
+	is.InfoBase
+	User string `json:"User"`
+}
+
+func (i *testInfo) String() string { return i.User }
+
+func init() {
+	is.RegisterInfo("struct_test.info", new(testInfo))
+	is.RegisterInfo("struct_test.time", new(timeInfo))
+}
+
+// Return a fake element with key and text [k]
+func el(k string) *fake.Element { return &fake.Element{Key: k, InnerText: k} }
+
+// Return a processor reading "article" elements of [property.Page] into [testInfo]
+func newTestProcessor(property *is.Property) *is.Processor {
+	if property.IInfoList == nil {
+		property.IInfoList = new(is.IInfoList)
+	}
+	p := is.New(property)
+	p.V020_Elements = func() { p.StateCurr.Elements, p.Err = p.Page.Elements("article") }
+	p.V030_ElementInfo = func() {
+		text, err := p.StateCurr.Element.Text()
+		p.StateCurr.ElementInfo, p.Err = &testInfo{User: text}, err
+	}
+	return p
+}
+
+// Return [IInfo.String], [testInfo.User], of [list]
+func users(list *is.IInfoList) (out []string) {
+	for _, info := range *list {
+		out = append(out, info.String())
+	}
+	return out
+}
+
+func TestRunAppendDedup(t *testing.T) {
+	a, b, c, d := el("a"), el("b"), el("c"), el("d")
+	page := fake.New(
+		fake.Snapshot{"article": {a, b}},
+		fake.Snapshot{"article": {a, b, c}},
+		fake.Snapshot{"article": {b, c, d}},
+	)
+	p := newTestProcessor(&is.Property{Page: page, ScrollMax: -1, StopCond: &is.StopNoNewElements{N: 1}})
+	report := p.Run()
+	if p.Err != nil {
+		t.Fatal(p.Err)
+	}
+	if got, want := users(p.IInfoList), []string{"a", "b", "c", "d"}; !slices.Equal(got, want) {
+		t.Errorf("infos = %v, want %v", got, want)
+	}
+	if report.ElementsSeen != 4 {
+		t.Errorf("ElementsSeen = %d, want 4", report.ElementsSeen)
+	}
+}
+
+func TestRunStopNoNewElements(t *testing.T) {
+	a, b := el("a"), el("b")
+	page := fake.New(
+		fake.Snapshot{"article": {a}},
+		fake.Snapshot{"article": {a, b}},
+	)
+	p := newTestProcessor(&is.Property{Page: page, ScrollMax: -1, StopCond: &is.StopNoNewElements{N: 2}})
+	report := p.Run()
+	if p.Err != nil {
+		t.Fatal(p.Err)
+	}
+	if got, want := users(p.IInfoList), []string{"a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("infos = %v, want %v", got, want)
+	}
+	// load, b found, then 2 iterations without new element
+	if report.ScrollCount != 4 {
+		t.Errorf("ScrollCount = %d, want 4", report.ScrollCount)
+	}
+}
+
+func TestRunStopSpec(t *testing.T) {
+	var property is.Property
+	if err := json.Unmarshal([]byte(`{"Stop": {"Kind": "Any", "Conds": [{"Kind": "NoNewElements", "N": 2}, {"Kind": "MaxItems", "N": 10}]}}`), &property); err != nil {
+		t.Fatal(err)
+	}
+	a, b := el("a"), el("b")
+	property.Page = fake.New(
+		fake.Snapshot{"article": {a}},
+		fake.Snapshot{"article": {a, b}},
+	)
+	property.ScrollMax = -1
+	p := newTestProcessor(&property)
+	if p.StopCond == nil || p.StopCond.String() != "(NoNewElements(2) OR MaxItems(10))" {
+		t.Fatalf("StopCond = %v", p.StopCond)
+	}
+	report := p.Run()
+	if p.Err != nil {
+		t.Fatal(p.Err)
+	}
+	if report.ScrollCount != 4 {
+		t.Errorf("ScrollCount = %d, want 4", report.ScrollCount)
+	}
+
+	p = newTestProcessor(&is.Property{Page: fake.New(), Stop: &is.StopSpec{Kind: "Bogus"}})
+	if p.Err == nil || p.Initialized {
+		t.Errorf("unknown kind: Err = %v, Initialized = %v", p.Err, p.Initialized)
+	}
+}
+
+func TestRunKeysCount(t *testing.T) {
+	page := fake.New(fake.Snapshot{"article": {el("a"), el("b")}})
+	p := newTestProcessor(&is.Property{Page: page})
+	p.V025_ElementKeys = func() { p.StateCurr.ElementKeys = []string{"a"} }
+	p.Run()
+	if p.Err == nil {
+		t.Fatal("Err = nil, want keys count error")
+	}
+	if n := len(*p.IInfoList); n != 0 {
+		t.Errorf("infos = %d, want 0", n)
+	}
+}
+
+func TestRunErrPolicy(t *testing.T) {
+	tests := []struct {
+		policy  is.ErrPolicy
+		want    []string
+		journal int
+		abort   bool
+	}{
+		{is.ErrPolicySkip, []string{"a", "c"}, 1, false},
+		{is.ErrPolicyRetry, []string{"a", "b", "c"}, 1, false},
+		{is.ErrPolicyAbort, []string{"a"}, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.policy.String(), func(t *testing.T) {
+			page := fake.New(fake.Snapshot{"article": {el("a"), el("b"), el("c")}})
+			p := newTestProcessor(&is.Property{Page: page, ErrPolicy: tt.policy, ErrRetryMax: 2})
+			v030, failed := p.V030_ElementInfo, false
+			p.V030_ElementInfo = func() {
+				if p.StateCurr.ElementKey == "b" && !failed {
+					failed = true
+					panic("b failed")
+				}
+				v030()
+			}
+			p.Run()
+			if got := users(p.IInfoList); !slices.Equal(got, tt.want) {
+				t.Errorf("infos = %v, want %v", got, tt.want)
+			}
+			if len(p.ErrJournal) != tt.journal {
+				t.Fatalf("journal = %d, want %d", len(p.ErrJournal), tt.journal)
+			}
+			if rec := p.ErrJournal[0]; rec.Stage != "V030" || rec.Policy != tt.policy || rec.Panic == nil {
+				t.Errorf("journal = %+v", rec)
+			}
+			var stageErr *is.StageError
+			if aborted := errors.As(p.Err, &stageErr); aborted != tt.abort {
+				t.Errorf("Err = %v, want abort %v", p.Err, tt.abort)
+			}
+		})
+	}
+}
+
+func TestRunScrollUp(t *testing.T) {
+	a, b, c, d := el("a"), el("b"), el("c"), el("d")
+	page := fake.New(
+		fake.Snapshot{"article": {c, d}},
+		fake.Snapshot{"article": {b, c, d}},
+		fake.Snapshot{"article": {a, b, c, d}},
+	)
+	p := newTestProcessor(&is.Property{Page: page, Direction: is.ScrollUp, ScrollMax: -1, StopCond: &is.StopNoNewElements{N: 1}})
+	p.Run()
+	if p.Err != nil {
+		t.Fatal(p.Err)
+	}
+	if got, want := users(p.IInfoList), []string{"a", "b", "c", "d"}; !slices.Equal(got, want) {
+		t.Errorf("infos = %v, want %v", got, want)
+	}
+}
+
+func TestRunCheckpointResume(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "checkpoint.json")
+	// virtualized list: earlier elements are gone after scrolling
+	snapshots := func() *fake.Page {
+		return fake.New(
+			fake.Snapshot{"article": {el("a"), el("b")}},
+			fake.Snapshot{"article": {el("c"), el("d")}},
+			fake.Snapshot{"article": {el("e"), el("f")}},
+		)
+	}
+	property := is.Property{ScrollMax: -1, StopCond: &is.StopNoNewElements{N: 1}, CheckpointFile: file, CheckpointResume: true}
+
+	// 1st run aborts at "e"
+	property.Page = snapshots()
+	p := newTestProcessor(&property)
+	v030 := p.V030_ElementInfo
+	p.V030_ElementInfo = func() {
+		if p.StateCurr.ElementKey == "e" {
+			p.Err = errors.New("e failed")
+			return
+		}
+		v030()
+	}
+	p.Run()
+	if p.Err == nil {
+		t.Fatal("1st run: Err = nil, want abort")
+	}
+	c, err := is.LoadCheckpoint(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.LastKey != "d" || slices.Contains(c.Seen, "e") {
+		t.Errorf("checkpoint LastKey = %q, Seen = %v", c.LastKey, c.Seen)
+	}
+
+	// checkpoint of another URL is an error, and is kept
+	other := property
+	other.Page, other.UrlStr, other.IInfoList = snapshots(), "https://example.com/other", nil
+	p = newTestProcessor(&other)
+	if p.Run(); p.Err == nil || !strings.Contains(p.Err.Error(), "checkpoint of") {
+		t.Errorf("other URL: Err = %v, want checkpoint URL error", p.Err)
+	}
+	if len(*p.IInfoList) != 0 {
+		t.Errorf("other URL: infos = %v, want none", users(p.IInfoList))
+	}
+	if c2, err := is.LoadCheckpoint(file); err != nil || c2.LastKey != "d" {
+		t.Errorf("other URL: checkpoint changed: %v, %v", c2, err)
+	}
+
+	// 2nd run fast-forwards past "a" .. "d", ignoring StopCond, and processes "e" and "f" only
+	property.Page = snapshots()
+	property.IInfoList = nil
+	p = newTestProcessor(&property)
+	var processed []string
+	v030 = p.V030_ElementInfo
+	p.V030_ElementInfo = func() {
+		processed = append(processed, p.StateCurr.ElementKey)
+		v030()
+	}
+	p.Run()
+	if p.Err != nil {
+		t.Fatal(p.Err)
+	}
+	if want := []string{"e", "f"}; !slices.Equal(processed, want) {
+		t.Errorf("processed = %v, want %v", processed, want)
+	}
+	if got, want := users(p.IInfoList), []string{"a", "b", "c", "d", "e", "f"}; !slices.Equal(got, want) {
+		t.Errorf("infos = %v, want %v", got, want)
+	}
+	if _, err := os.Stat(file); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("checkpoint not removed after clean run: %v", err)
+	}
+}
+
+func TestRunWatermark(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "watermark.json")
+	property := is.Property{WatermarkFile: file}
+
+	property.Page = fake.New(fake.Snapshot{"article": {el("a"), el("b")}})
+	p := newTestProcessor(&property)
+	if p.Run(); p.Err != nil {
+		t.Fatal(p.Err)
+	}
+
+	property.Page = fake.New(fake.Snapshot{"article": {el("c"), el("a"), el("b")}})
+	property.IInfoList = nil
+	p = newTestProcessor(&property)
+	report := p.Run()
+	if p.Err != nil {
+		t.Fatal(p.Err)
+	}
+	if got, want := users(p.IInfoList), []string{"c"}; !slices.Equal(got, want) {
+		t.Errorf("infos = %v, want %v", got, want)
+	}
+	if report.Known != 2 {
+		t.Errorf("Known = %d, want 2", report.Known)
+	}
+	w, err := is.LoadWatermark(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"c", "b", "a"}; !slices.Equal(w.Keys, want) {
+		t.Errorf("watermark keys = %v, want %v", w.Keys, want)
+	}
+
+	// failed run leaves watermark unchanged
+	property.Page = fake.New(fake.Snapshot{"article": {el("e"), el("d"), el("c")}})
+	property.IInfoList = nil
+	p = newTestProcessor(&property)
+	v030 := p.V030_ElementInfo
+	p.V030_ElementInfo = func() {
+		if p.StateCurr.ElementKey == "d" {
+			p.Err = errors.New("d failed")
+			return
+		}
+		v030()
+	}
+	if p.Run(); p.Err == nil {
+		t.Fatal("Err = nil, want abort")
+	}
+	if w, err = is.LoadWatermark(file); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"c", "b", "a"}; !slices.Equal(w.Keys, want) {
+		t.Errorf("watermark keys after failed run = %v, want %v", w.Keys, want)
+	}
+}
+
+type timeInfo struct {
+	testInfo
+	T time.Time
+}
+
+func (i *timeInfo) Time() time.Time { return i.T }
+
+// Record result of [IStop] in each iteration
+type stopSpy struct {
+	is.IStop
+	got []bool
+}
+
+func (s *stopSpy) Stop(t *is.Processor) (bool, error) {
+	stop, err := s.IStop.Stop(t)
+	s.got = append(s.got, stop)
+	return stop, err
+}
+
+func TestRunKnownInRow(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "watermark.json")
+	mark := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	if err := (&is.Watermark{Time: mark, Keys: []string{"c", "d", "e"}}).Save(file); err != nil {
+		t.Fatal(err)
+	}
+	timed := func(k string, d time.Duration) *fake.Element {
+		e := el(k)
+		e.Attrs = map[string]string{"time": mark.Add(d).Format(time.RFC3339)}
+		return e
+	}
+	// known by time, new, known by key, known by key
+	x, b, c, d, e := timed("x", -time.Hour), timed("b", time.Hour), el("c"), el("d"), el("e")
+	page := fake.New(
+		fake.Snapshot{"article": {x, b, c, d}},
+		fake.Snapshot{"article": {x, b, c, d, e}},
+	)
+	spy := &stopSpy{IStop: &is.StopKnownInRow{N: 3}}
+	p := newTestProcessor(&is.Property{Page: page, ScrollMax: -1, StopCond: spy, WatermarkFile: file})
+	p.V030_ElementInfo = func() {
+		info := &timeInfo{}
+		info.User, p.Err = p.StateCurr.Element.Text()
+		if tm, _ := p.StateCurr.Element.Attribute("time"); tm != nil {
+			info.T, p.Err = time.Parse(time.RFC3339, *tm)
+		}
+		p.StateCurr.ElementInfo = info
+	}
+	report := p.Run()
+	if p.Err != nil {
+		t.Fatal(p.Err)
+	}
+	if want := []bool{false, true}; !slices.Equal(spy.got, want) {
+		t.Errorf("stop = %v, want %v", spy.got, want)
+	}
+	if got, want := users(p.IInfoList), []string{"b"}; !slices.Equal(got, want) {
+		t.Errorf("infos = %v, want %v", got, want)
+	}
+	if report.Known != 4 {
+		t.Errorf("Known = %d, want 4", report.Known)
+	}
+}
+
+func TestRunBatch(t *testing.T) {
+	data := func(k string) *fake.Element {
+		e := el(k)
+		e.Data = map[string]string{"User": k}
+		return e
+	}
+	a, b, c, d := data("a"), el("b"), data("c"), data("d")
+	b.Data = map[string]string{"__error": "boom"}
+	ad := el("ad") // extractor returned null
+	page := fake.New(
+		fake.Snapshot{"article": {a, b, ad}},
+		fake.Snapshot{"article": {a, b, ad, c, d}},
+	)
+	p := newTestProcessor(&is.Property{
+		Page:       page,
+		ScrollMax:  -1,
+		StopCond:   &is.StopNoNewElements{N: 1},
+		ErrPolicy:  is.ErrPolicySkip,
+		ExtractJS:  "el => el.dataset",
+		ExtractNew: func() is.IInfo { return new(testInfo) },
+	})
+	p.V030_ElementInfo = func() { t.Error("V030 called in batch mode") }
+	report := p.Run()
+	if p.Err != nil {
+		t.Fatal(p.Err)
+	}
+	if got, want := users(p.IInfoList), []string{"a", "c", "d"}; !slices.Equal(got, want) {
+		t.Errorf("infos = %v, want %v", got, want)
+	}
+	if len(p.ErrJournal) != 1 || p.ErrJournal[0].ElementIndex != 1 {
+		t.Errorf("ErrJournal = %v, want 1 record of element 1", p.ErrJournal)
+	}
+	if report.ElementsSeen != 5 {
+		t.Errorf("ElementsSeen = %d, want 5", report.ElementsSeen)
+	}
+}
+
+func TestRunAdvanceDisabled(t *testing.T) {
+	more := &fake.Element{Key: "more", Disabled: true}
+	page := fake.New(fake.Snapshot{"article": {el("a"), el("b")}, "#more": {more}})
+	p := newTestProcessor(&is.Property{Page: page, ScrollMax: -1, AdvanceMode: is.AdvanceClick, AdvanceSelector: "#more"})
+	p.Run()
+	if p.Err != nil {
+		t.Fatal(p.Err)
+	}
+	if !p.StateCurr.AdvanceEnd || more.Clicks != 0 {
+		t.Errorf("AdvanceEnd = %v, clicks = %d, want true, 0", p.StateCurr.AdvanceEnd, more.Clicks)
+	}
+	if got, want := users(p.IInfoList), []string{"a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("infos = %v, want %v", got, want)
+	}
+}
+
+// Sink failing its first write
+type flakySink struct {
+	listSink
+	failed bool
+}
+
+func (s *flakySink) Write(info is.IInfo) error {
+	if !s.failed {
+		s.failed = true
+		return errors.New("sink failed")
+	}
+	return s.listSink.Write(info)
+}
+
+func TestRunSinkRetry(t *testing.T) {
+	first, flaky := new(listSink), new(flakySink)
+	page := fake.New(fake.Snapshot{"article": {el("a"), el("b")}})
+	p := newTestProcessor(&is.Property{Page: page, Sinks: []is.ISink{first, flaky}, ErrPolicy: is.ErrPolicyRetry, ErrRetryMax: 1})
+	p.Run()
+	if p.Err != nil {
+		t.Fatal(p.Err)
+	}
+	// retry writes "a" to the failed sink only
+	if got, want := users(&first.list), []string{"a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("1st sink = %v, want %v", got, want)
+	}
+	if got, want := users(&flaky.list), []string{"a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("2nd sink = %v, want %v", got, want)
+	}
+}
+
+// Return a page of [n] articles, keyed "0" .. "n-1"
+func streamPage(n int) *fake.Page {
+	var es []*fake.Element
+	for i := range n {
+		es = append(es, el(strconv.Itoa(i)))
+	}
+	return fake.New(fake.Snapshot{"article": es})
+}
+
+// Count V030 calls of [p]
+func countV030(p *is.Processor) *atomic.Int32 {
+	var n atomic.Int32
+	v030 := p.V030_ElementInfo
+	p.V030_ElementInfo = func() {
+		n.Add(1)
+		v030()
+	}
+	return &n
+}
+
+func TestStreamChan(t *testing.T) {
+	p := newTestProcessor(&is.Property{Page: streamPage(5)})
+	calls := countV030(p)
+	infos, errs := p.StreamChan(context.Background(), 0)
+
+	// back-pressure: run waits for the consumer, at most one info is extracted ahead
+	first := <-infos
+	time.Sleep(20 * time.Millisecond)
+	if n := calls.Load(); n > 2 {
+		t.Errorf("V030 calls after 1 info received = %d, want <= 2", n)
+	}
+	got := []string{first.String()}
+	for info := range infos {
+		got = append(got, info.String())
+	}
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"0", "1", "2", "3", "4"}; !slices.Equal(got, want) {
+		t.Errorf("infos = %v, want %v", got, want)
+	}
+	if got := users(p.IInfoList); len(got) != 5 {
+		t.Errorf("IInfoList = %v, want 5 infos", got)
+	}
+
+	// canceling ctx ends the run, and the error is sent after the info channel is closed
+	ctx, cancel := context.WithCancel(context.Background())
+	p = newTestProcessor(&is.Property{Page: streamPage(5), ScrollMax: -1})
+	infos, errs = p.StreamChan(ctx, 0)
+	<-infos
+	cancel()
+	for range infos {
+	}
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Errorf("Err = %v, want context.Canceled", err)
+	}
+}
+
+func TestStream(t *testing.T) {
+	// breaking out of the loop cancels the run
+	p := newTestProcessor(&is.Property{Page: streamPage(5), ScrollMax: -1})
+	calls := countV030(p)
+	var got []string
+	for info, err := range p.Stream(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got = append(got, info.String()); len(got) == 2 {
+			break
+		}
+	}
+	if want := []string{"0", "1"}; !slices.Equal(got, want) {
+		t.Errorf("infos = %v, want %v", got, want)
+	}
+	if n := calls.Load(); n > 3 {
+		t.Errorf("V030 calls = %d, want <= 3", n)
+	}
+	if !errors.Is(p.Err, context.Canceled) {
+		t.Errorf("Err = %v, want context.Canceled", p.Err)
+	}
+
+	// failed run yields (nil, Err) last
+	p = newTestProcessor(&is.Property{Page: streamPage(3)})
+	v030 := p.V030_ElementInfo
+	p.V030_ElementInfo = func() {
+		if p.StateCurr.ElementKey == "1" {
+			p.Err = errors.New("1 failed")
+			return
+		}
+		v030()
+	}
+	var errLast error
+	got = nil
+	for info, err := range p.Stream(context.Background()) {
+		if err != nil {
+			errLast = err
+			continue
+		}
+		got = append(got, info.String())
+	}
+	if want := []string{"0"}; !slices.Equal(got, want) {
+		t.Errorf("infos = %v, want %v", got, want)
+	}
+	if errLast == nil || !strings.Contains(errLast.Error(), "1 failed") {
+		t.Errorf("last err = %v, want \"1 failed\"", errLast)
+	}
+}
+
+func TestTypedProcessor(t *testing.T) {
+	property := &is.Property{Page: fake.New(fake.Snapshot{"article": {el("d"), el("nil"), el("none"), el("a")}})}
+	p := typed.New[*testInfo](property)
+	p.V020_Elements = func() { p.StateCurr.Elements, p.Err = p.Page.Elements("article") }
+	p.V030_ElementInfo = func() (*testInfo, bool) {
+		switch text, _ := p.StateCurr.Element.Text(); text {
+		case "nil":
+			return nil, true // typed nil is skipped
+		case "none":
+			return nil, false
+		default:
+			return &testInfo{User: text}, true
+		}
+	}
+	var processed []string
+	p.V070_ElementProcess = func() {
+		if info, ok := p.Info(); ok {
+			processed = append(processed, info.User)
+		}
+	}
+	p.Run()
+	if p.Err != nil {
+		t.Fatal(p.Err)
+	}
+	if property.IInfoList != nil {
+		t.Error("caller property IInfoList changed")
+	}
+	infos := p.Infos()
+	if len(infos) != 2 || infos[0].User != "d" || infos[1].User != "a" {
+		t.Fatalf("Infos() = %v, want [d a]", infos)
+	}
+	if want := []string{"d", "a"}; !slices.Equal(processed, want) {
+		t.Errorf("V070 infos = %v, want %v", processed, want)
+	}
+	sort.Sort(infos)
+	if infos[0].User != "a" {
+		t.Errorf("sorted = %v, want a first", infos)
+	}
+	if got := users(ptr(infos.IInfoList())); !slices.Equal(got, []string{"a", "d"}) {
+		t.Errorf("IInfoList() = %v", got)
+	}
+
+	// items of other types are skipped and reported
+	list, err := typed.InfoListOf[*testInfo](is.IInfoList{&testInfo{User: "x"}, &timeInfo{}, &testInfo{User: "y"}})
+	if err == nil || len(list) != 2 {
+		t.Errorf("InfoListOf() = %v, %v, want 2 items and error", list, err)
+	}
+
+	// typed Stream
+	p = typed.New[*testInfo](&is.Property{Page: streamPage(3)})
+	p.V020_Elements = func() { p.StateCurr.Elements, p.Err = p.Page.Elements("article") }
+	p.V030_ElementInfo = func() (*testInfo, bool) {
+		text, _ := p.StateCurr.Element.Text()
+		return &testInfo{User: text}, true
+	}
+	var streamed []string
+	for info, err := range p.Stream(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		streamed = append(streamed, info.User)
+	}
+	if want := []string{"0", "1", "2"}; !slices.Equal(streamed, want) {
+		t.Errorf("Stream() = %v, want %v", streamed, want)
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
+
+// Info type not registered
+type unregInfo struct{ is.InfoBase }
+
+func TestIInfoListJSON(t *testing.T) {
+	a := &testInfo{User: "a"}
+	a.SetMatched(true)
+	a.SetMatchedStr("vip")
+	a.SetLabel("spam", 0.5)
+	tm := &timeInfo{T: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)}
+	tm.User = "t"
+	list := is.IInfoList{a, nil, tm}
+	b, err := json.Marshal(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got is.IInfoList
+	if err = json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("%v\n%s", err, b)
+	}
+	if len(got) != 2 {
+		t.Fatalf("unmarshalled %d infos, want 2 (nil skipped): %s", len(got), b)
+	}
+	ga, ok := got[0].(*testInfo)
+	if !ok || ga.User != "a" || !ga.Matched() || ga.MatchedStr() != "vip" {
+		t.Errorf("info 0 = %#v, want matched testInfo a", got[0])
+	}
+	if score, _ := ga.Label("spam"); score != 0.5 {
+		t.Errorf("label spam = %v, want 0.5", score)
+	}
+	if gt, ok := got[1].(*timeInfo); !ok || gt.User != "t" || !gt.T.Equal(tm.T) {
+		t.Errorf("info 1 = %#v, want timeInfo t", got[1])
+	}
+
+	// unregistered type is marshalled with its Go type name, and cannot be unmarshalled
+	b, err = json.Marshal(is.IInfoList{new(unregInfo)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"Type":"*is_test.unregInfo"`) {
+		t.Errorf("JSON = %s, want Go type name", b)
+	}
+	if err = json.Unmarshal(b, &got); err == nil || !strings.Contains(err.Error(), "not registered") {
+		t.Errorf("Unmarshal() = %v, want not registered error", err)
+	}
+}
+
+func TestRunLabelProcess(t *testing.T) {
+	page := fake.New(fake.Snapshot{"article": {el("a"), el("spam1"), el("vip-spam"), el("bad")}})
+	spam := new(listSink)
+	var (
+		p     *is.Processor
+		hooks []string
+	)
+	p = newTestProcessor(&is.Property{
+		Page:      page,
+		ErrPolicy: is.ErrPolicySkip,
+		LabelProcess: map[string]is.ProcessorFunc{
+			"spam": func() {
+				hooks = append(hooks, "spam:"+p.StateCurr.ElementKey)
+				p.Err = spam.Write(p.StateCurr.ElementInfo)
+			},
+			"vip": func() { hooks = append(hooks, "vip:"+p.StateCurr.ElementKey) },
+			"bad": func() { p.Err = errors.New("bad hook") },
+		},
+	})
+	p.V040_ElementMatch = func() {
+		info := p.StateCurr.ElementInfo.(*testInfo)
+		for _, label := range []string{"spam", "vip", "bad"} {
+			if strings.Contains(info.User, label) {
+				info.SetLabel(label, 1)
+			}
+		}
+	}
+	report := p.Run()
+	if p.Err != nil {
+		t.Fatal(p.Err)
+	}
+	// hooks run in label order
+	if want := []string{"spam:spam1", "spam:vip-spam", "vip:vip-spam"}; !slices.Equal(hooks, want) {
+		t.Errorf("hooks = %v, want %v", hooks, want)
+	}
+	if got, want := users(&spam.list), []string{"spam1", "vip-spam"}; !slices.Equal(got, want) {
+		t.Errorf("spam sink = %v, want %v", got, want)
+	}
+	// failed hook skips the item
+	if got, want := users(p.IInfoList), []string{"a", "spam1", "vip-spam"}; !slices.Equal(got, want) {
+		t.Errorf("infos = %v, want %v", got, want)
+	}
+	if len(p.ErrJournal) != 1 || p.ErrJournal[0].Stage != "Label:bad" {
+		t.Errorf("ErrJournal = %v, want 1 record of Label:bad", p.ErrJournal)
+	}
+	if report.Labels["spam"] != 2 || report.Labels["vip"] != 1 || report.Labels["bad"] != 1 {
+		t.Errorf("report labels = %v", report.Labels)
+	}
+	if got, want := users(ptr(p.IInfoList.WithLabel("vip"))), []string{"vip-spam"}; !slices.Equal(got, want) {
+		t.Errorf("WithLabel(vip) = %v, want %v", got, want)
+	}
+}
+
+func TestRecordReplay(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "record.json")
+	html := func(k string) *fake.Element {
+		e := el(k)
+		e.OuterHTML = "<article>" + k + "</article>"
+		return e
+	}
+	snapshots := func() *fake.Page {
+		a, b, c := html("a"), html("b"), html("c")
+		return fake.New(
+			fake.Snapshot{"article": {a, b}},
+			fake.Snapshot{"article": {a, b, c}},
+		)
+	}
+	property := &is.Property{Page: snapshots(), UrlStr: "https://example.com/feed", ScrollMax: -1, StopCond: &is.StopNoNewElements{N: 1}, RecordFile: file}
+	p := newTestProcessor(property)
+	if p.Run(); p.Err != nil {
+		t.Fatal(p.Err)
+	}
+	rec, err := is.LoadRecording(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// load, c found, then 1 iteration without new element
+	if len(rec.Frames) != 3 {
+		t.Fatalf("frames = %d, want 3", len(rec.Frames))
+	}
+	if want := []string{"<article>a</article>", "<article>b</article>", "<article>c</article>"}; !slices.Equal(rec.Frames[1].Elements, want) {
+		t.Errorf("frame 1 elements = %v, want %v", rec.Frames[1].Elements, want)
+	}
+	if rec.Frames[1].State == nil || rec.Frames[1].State.ElementsNew != 1 {
+		t.Errorf("frame 1 state = %+v, want 1 new element", rec.Frames[1].State)
+	}
+	if got, want := users(ptr(rec.Infos())), []string{"a", "b", "c"}; !slices.Equal(got, want) {
+		t.Errorf("recorded infos = %v, want %v", got, want)
+	}
+	if doc := rec.Document(0); !strings.Contains(doc, `<base href="https://example.com/feed">`) || !strings.Contains(doc, "<article>b</article>") {
+		t.Errorf("document 0 = %s", doc)
+	}
+
+	// replay in the same processor: Seen, Known and IInfoList of the previous run do not leak into it
+	p.Known = map[string]bool{"a": true}
+	page := snapshots()
+	replay := is.NewReplayPage(page, rec)
+	var rendered []int
+	replay.Render = func(_ is.IPage, _ string) error {
+		rendered = append(rendered, replay.Frame)
+		page.Script.Index = min(replay.Frame, len(page.Script.Snapshots)-1)
+		return nil
+	}
+	report := p.Replay(context.Background(), replay)
+	if p.Err != nil {
+		t.Fatal(p.Err)
+	}
+	if got, want := users(p.IInfoList), users(ptr(rec.Infos())); !slices.Equal(got, want) {
+		t.Errorf("replayed infos = %v, want %v", got, want)
+	}
+	if report.Known != 0 {
+		t.Errorf("Known = %d, want 0", report.Known)
+	}
+	if want := []int{0, 1, 2}; !slices.Equal(rendered, want) {
+		t.Errorf("rendered frames = %v, want %v", rendered, want)
+	}
+	// property is restored
+	if p.RecordFile != file || p.StopCond == nil || p.Page != is.IPage(property.Page) {
+		t.Error("property not restored after replay")
+	}
+}
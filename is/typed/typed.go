@@ -0,0 +1,184 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+// Package [typed] is a generic, type-safe layer over [is.Processor] and [is.IInfoList].
+//
+// The [is.IInfo] based API of [is] is unchanged. [Processor] wraps it, so that
+// [Processor.V030_ElementInfo] return `T` and results are `[]T`, without type assertion.
+package typed
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"reflect"
+	"strconv"
+
+	"github.com/J-Siu/go-is/v3/is"
+)
+
+// Typed IS processor structure
+//
+// Embed `*typed.Processor[T]` instead of `*is.Processor`. All [is.Processor] fields and field functions are available.
+type Processor[T is.IInfo] struct {
+	*is.Processor
+
+	// Extract information from [StateCurr.Element] and return it.
+	//
+	// Return `ok` = false if there is no info for the element.
+	//
+	// Shadows [is.Processor.V030_ElementInfo], which is set to call this function.
+	//
+	// **Must override**
+	V030_ElementInfo func() (info T, ok bool) `json:"-"`
+}
+
+// Parameters:
+//   - property *is.Property
+//
+// Returns:
+//   - *Processor[T]
+func New[T is.IInfo](property *is.Property) *Processor[T] { return new(Processor[T]).New(property) }
+
+// Parameters:
+//   - property *is.Property. [IInfoList] is allocated if nil. [property] itself is not modified.
+//
+// Returns:
+//   - *Processor[T]
+func (t *Processor[T]) New(property *is.Property) *Processor[T] {
+	if property != nil && property.IInfoList == nil {
+		p := *property
+		p.IInfoList = new(is.IInfoList)
+		property = &p
+	}
+	t.Processor = is.New(property)
+	t.V030_ElementInfo = t.base_V030_ElementInfo
+	t.Processor.V030_ElementInfo = t.v030
+	return t
+}
+
+// Adapter of typed [V030_ElementInfo] to [is.Processor.V030_ElementInfo]
+//
+// A nil `T` (eg. typed nil pointer) is treated as no info, even if `ok` is true.
+func (t *Processor[T]) v030() {
+	t.StateCurr.ElementInfo = nil
+	if info, ok := t.V030_ElementInfo(); ok && !isNil(info) {
+		t.StateCurr.ElementInfo = info
+	}
+}
+
+// Return true if [v] is nil, or a nil pointer, map, slice, func, chan or interface
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
+
+func (t *Processor[T]) base_V030_ElementInfo() (info T, ok bool) {
+	prefix := t.MyType + ".V030_ElementInfo" + "(typed base)"
+	t.StateCurr.Name = prefix
+	if t.Logger != nil {
+		t.Logger.Trace().N(prefix).M("Do nothing. Return `false`").Out()
+	}
+	return info, false
+}
+
+// Return [StateCurr.ElementInfo] as `T`. `ok` is false if it is nil or not a `T`.
+func (t *Processor[T]) Info() (info T, ok bool) {
+	if t.StateCurr != nil {
+		info, ok = t.StateCurr.ElementInfo.(T)
+	}
+	return info, ok
+}
+
+// Return [IInfoList] as [InfoList]
+func (t *Processor[T]) Infos() InfoList[T] {
+	if t.IInfoList == nil {
+		return nil
+	}
+	list, _ := InfoListOf[T](*t.IInfoList)
+	return list
+}
+
+// Same as [is.Processor.Stream], yielding `T`
+func (t *Processor[T]) Stream(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for info, err := range t.Processor.Stream(ctx) {
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			v, ok := info.(T)
+			if !ok {
+				yield(zero, errors.New(t.MyType+".Stream(typed): unexpected info type"))
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Typed list of info
+//
+// Implemented Print() and sort.Interface, same as [is.IInfoList]
+type InfoList[T is.IInfo] []T
+
+// Convert [is.IInfoList] to [InfoList]. Items not of type `T` are skipped and reported in [err].
+func InfoListOf[T is.IInfo](list is.IInfoList) (out InfoList[T], err error) {
+	out = make(InfoList[T], 0, len(list))
+	for i, info := range list {
+		if v, ok := info.(T); ok {
+			out = append(out, v)
+		} else if err == nil {
+			err = errors.New("InfoListOf: item " + strconv.Itoa(i) + " is not of type T")
+		}
+	}
+	return out, err
+}
+
+// Convert to [is.IInfoList]
+func (l InfoList[T]) IInfoList() is.IInfoList {
+	out := make(is.IInfoList, len(l))
+	for i, info := range l {
+		out[i] = info
+	}
+	return out
+}
+
+func (l InfoList[T]) Print(mode is.IInfoListPrintMode) {
+	list := l.IInfoList()
+	list.Print(mode)
+}
+
+func (l InfoList[T]) Len() int { return len(l) }
+func (l InfoList[T]) Less(i, j int) bool {
+	return l[i].String() < l[j].String()
+}
+func (l InfoList[T]) Swap(i, j int) { l[i], l[j] = l[j], l[i] }
@@ -0,0 +1,198 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package is
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"time"
+)
+
+// Returned (wrapped) when a wait strategy exceeds its timeout
+var ErrWaitTimeout = errors.New("wait timeout")
+
+// Interface for wait strategy after loading and scrolling
+//
+// Used by [Property.WaitLoad] and [Property.WaitScroll].
+type IWait interface {
+	Wait(t *Processor) error // Wait until page is ready
+	String() string          // Description for logging
+}
+
+// Hard timeout of built-in wait strategies with `Timeout` = 0
+const WaitTimeoutDefault = 30 * time.Second
+
+// Default wait strategy, same as [rod.Page.MustWaitDOMStable] without panic, bound to [WaitTimeoutDefault]
+var WaitDefault IWait = &WaitDOMStable{D: time.Second, Timeout: WaitTimeoutDefault}
+
+// Run [f] with a page bound to [timeout]. Exceeding [timeout] is returned as [ErrWaitTimeout].
+//
+// [timeout] = 0 means [WaitTimeoutDefault]. [timeout] < 0 means no timeout.
+func waitTimeout(t *Processor, name string, timeout time.Duration, f func(page IPage) error) (err error) {
+	if timeout < 0 {
+		return f(t.Page)
+	}
+	if timeout == 0 {
+		timeout = WaitTimeoutDefault
+	}
+	ctx, cancel := context.WithTimeout(t.Page.GetContext(), timeout)
+	defer cancel()
+	err = f(t.Page.Context(ctx))
+	if err != nil && ctx.Err() != nil && t.Page.GetContext().Err() == nil {
+		err = fmt.Errorf("%s: %s: %w", name, timeout, ErrWaitTimeout)
+	}
+	return err
+}
+
+// -- Built-in wait strategies
+
+// Wait until DOM change is less or equal than [Diff] for [D]. See [IPage.WaitDOMStable]
+type WaitDOMStable struct {
+	D       time.Duration // Stable duration. (default: 1s)
+	Diff    float64       // Maximum DOM change ratio
+	Timeout time.Duration // Hard timeout. (default: [WaitTimeoutDefault]). < 0 for none
+}
+
+func (w *WaitDOMStable) Wait(t *Processor) error {
+	d := w.D
+	if d == 0 {
+		d = time.Second
+	}
+	return waitTimeout(t, w.String(), w.Timeout, func(page IPage) error { return page.WaitDOMStable(d, w.Diff) })
+}
+func (w *WaitDOMStable) String() string { return "DOMStable(" + w.D.String() + ")" }
+
+// Wait until no network request for [D]. See [IPage.WaitIdle]
+type WaitNetworkIdle struct {
+	D       time.Duration // Idle duration. (default: 500ms)
+	Timeout time.Duration // Hard timeout. (default: [WaitTimeoutDefault]). < 0 for none
+}
+
+func (w *WaitNetworkIdle) Wait(t *Processor) error {
+	d := w.D
+	if d == 0 {
+		d = 500 * time.Millisecond
+	}
+	return waitTimeout(t, w.String(), w.Timeout, func(page IPage) error {
+		return page.WaitIdle(d)
+	})
+}
+func (w *WaitNetworkIdle) String() string { return "NetworkIdle(" + w.D.String() + ")" }
+
+// Wait until an element matching css [Selector] exists
+type WaitSelector struct {
+	Selector string
+	Timeout  time.Duration // Hard timeout. (default: [WaitTimeoutDefault]). < 0 for none
+}
+
+func (w *WaitSelector) Wait(t *Processor) error {
+	return waitTimeout(t, w.String(), w.Timeout, func(page IPage) error {
+		_, err := page.Element(w.Selector)
+		return err
+	})
+}
+func (w *WaitSelector) String() string { return "Selector(" + w.Selector + ")" }
+
+// Wait until number of elements matching css [Selector] is more than [State.ElementsCount] of current iteration
+//
+// Not suitable for [Property.Virtualized], as element count does not increase.
+type WaitCountIncrease struct {
+	Selector string
+	Interval time.Duration // Polling interval. (default: 200ms)
+	Timeout  time.Duration // Hard timeout. (default: [WaitTimeoutDefault]). < 0 for none
+}
+
+func (w *WaitCountIncrease) Wait(t *Processor) error {
+	interval := w.Interval
+	if interval == 0 {
+		interval = 200 * time.Millisecond
+	}
+	count := t.StateCurr.ElementsCount
+	return waitTimeout(t, w.String(), w.Timeout, func(page IPage) error {
+		for {
+			es, err := page.Elements(w.Selector)
+			if err != nil {
+				return err
+			}
+			if len(es) > count {
+				return nil
+			}
+			select {
+			case <-page.GetContext().Done():
+				return page.GetContext().Err()
+			case <-time.After(interval):
+			}
+		}
+	})
+}
+func (w *WaitCountIncrease) String() string { return "CountIncrease(" + w.Selector + ")" }
+
+// Sleep for [D] plus a random duration up to [Jitter]
+type WaitSleep struct {
+	D      time.Duration
+	Jitter time.Duration
+}
+
+func (w *WaitSleep) Wait(t *Processor) error {
+	d := w.D
+	if w.Jitter > 0 {
+		d += rand.N(w.Jitter)
+	}
+	select {
+	case <-t.Page.GetContext().Done():
+		return t.Page.GetContext().Err()
+	case <-time.After(d):
+	}
+	return nil
+}
+func (w *WaitSleep) String() string { return "Sleep(" + w.D.String() + "+" + w.Jitter.String() + ")" }
+
+// User wait function with hard timeout
+//
+// [F] should use [page] given, which is bound to [Timeout].
+type WaitFunc struct {
+	Name    string
+	F       func(t *Processor, page IPage) error
+	Timeout time.Duration // Hard timeout. (default: [WaitTimeoutDefault]). < 0 for none
+}
+
+func (w *WaitFunc) Wait(t *Processor) error {
+	return waitTimeout(t, w.String(), w.Timeout, func(page IPage) error { return w.F(t, page) })
+}
+func (w *WaitFunc) String() string { return "Func(" + w.Name + ")" }
+
+// Wait with [w], or [WaitDefault] if nil
+func (t *Processor) wait(w IWait) (err error) {
+	if w == nil {
+		w = WaitDefault
+	}
+	start := time.Now()
+	err = w.Wait(t)
+	if t.Logger != nil {
+		t.Logger.Trace().N(t.MyType + ".Wait").N(w.String()).M(strconv.FormatInt(time.Since(start).Milliseconds(), 10) + "ms").Out()
+	}
+	return err
+}
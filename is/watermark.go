@@ -0,0 +1,170 @@
+/*
+Copyright © 2026 John, Sing Dao, Siu <john.sd.siu@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+
+package is
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Optional interface for info struct with a timestamp
+//
+// Used by [Property.WatermarkFile]. Info not newer than [Watermark.Time] is treated as known.
+type IInfoTime interface {
+	Time() time.Time // Return the time of the item. eg. post time
+}
+
+// Default [Property.WatermarkMaxKeys]
+const WatermarkMaxKeysDefault = 10000
+
+// Items seen by previous runs, saved to [Property.WatermarkFile]
+type Watermark struct {
+	Time time.Time `json:"Time"` // Newest [IInfoTime] seen
+	Keys []string  `json:"Keys"` // Element/info keys seen, newest first
+}
+
+// Load watermark from [file]. An empty watermark is returned if [file] does not exist.
+func LoadWatermark(file string) (w *Watermark, err error) {
+	var b []byte
+	w = new(Watermark)
+	b, err = os.ReadFile(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return w, nil
+	}
+	if err == nil {
+		err = json.Unmarshal(b, w)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("LoadWatermark: %w", err)
+	}
+	return w, nil
+}
+
+// Save watermark to [file]. File is replaced atomically.
+func (w *Watermark) Save(file string) (err error) {
+	var b []byte
+	b, err = json.Marshal(w)
+	if err == nil {
+		err = writeFileAtomic(file, b)
+	}
+	if err != nil {
+		err = fmt.Errorf("Watermark.Save: %w", err)
+	}
+	return err
+}
+
+// Stop after [N] known items (seen by a previous run, see [Property.WatermarkFile]) in a row
+type StopKnownInRow struct {
+	N int
+}
+
+func (s *StopKnownInRow) Reset() {}
+func (s *StopKnownInRow) Stop(t *Processor) (bool, error) {
+	return t.knownInRow >= s.N, nil
+}
+func (s *StopKnownInRow) String() string { return "KnownInRow(" + strconv.Itoa(s.N) + ")" }
+
+// Load [WatermarkFile] into [Known]
+func (t *Processor) watermarkLoad() {
+	prefix := t.MyType + ".WatermarkLoad"
+	t.StateCurr.Name = prefix
+	w, err := LoadWatermark(t.WatermarkFile)
+	if err != nil {
+		t.Err = fmt.Errorf("%s: %w", prefix, err)
+		return
+	}
+	t.watermark = w
+	t.Known = make(map[string]bool, len(w.Keys))
+	for _, key := range w.Keys {
+		t.Known[key] = true
+	}
+	if t.Logger != nil {
+		t.Logger.Debug().N(prefix).N("keys").M(len(w.Keys)).N("time").M(w.Time).Out()
+	}
+}
+
+// Save keys and newest time of this run, followed by previous keys, to [WatermarkFile]
+//
+// Called only when the run completes without error.
+func (t *Processor) watermarkSave() (err error) {
+	if t.watermark == nil {
+		return nil
+	}
+	maxKeys := t.WatermarkMaxKeys
+	if maxKeys <= 0 {
+		maxKeys = WatermarkMaxKeysDefault
+	}
+	w := &Watermark{Time: t.watermark.Time}
+	for i := len(t.runKeys) - 1; i >= 0; i-- {
+		w.Keys = append(w.Keys, t.runKeys[i])
+	}
+	w.Keys = append(w.Keys, t.watermark.Keys...)
+	if len(w.Keys) > maxKeys {
+		w.Keys = w.Keys[:maxKeys]
+	}
+	if t.runTime.After(w.Time) {
+		w.Time = t.runTime
+	}
+	err = w.Save(t.WatermarkFile)
+	if err != nil {
+		err = fmt.Errorf("%s: %w", t.MyType+".WatermarkSave", err)
+	}
+	return err
+}
+
+// Count a known item, in DOM order. Any new item resets [knownInRow]. See [StopKnownInRow]
+func (t *Processor) knownItem() {
+	t.knownInRow++
+	t.Report.Known++
+}
+
+// Return true if [info] time is not newer than watermark time. Update newest time of this run.
+func (t *Processor) knownTime(info IInfo) bool {
+	it, ok := info.(IInfoTime)
+	if !ok {
+		return false
+	}
+	tm := it.Time()
+	if tm.After(t.runTime) {
+		t.runTime = tm
+	}
+	if t.watermark == nil || t.watermark.Time.IsZero() || tm.IsZero() {
+		return false
+	}
+	return !tm.After(t.watermark.Time)
+}
+
+// Mark [key] as seen in this run
+func (t *Processor) see(key string) { t.Seen[key] = true }
+
+// Add [key] to keys saved to [WatermarkFile]. Called once V030 succeeded for the element.
+func (t *Processor) remember(key string) {
+	if t.watermark != nil {
+		t.runKeys = append(t.runKeys, key)
+	}
+}